@@ -0,0 +1,251 @@
+// Package pflag exposes a helium FlagSet through the API modelled after spf13/pflag,
+// so that the code using pflag may be migrated by switching the import.
+//
+// Command-line arguments are tokenized following the pflag rules: flags and positional
+// arguments may be interspersed, `--` terminates the flags, a flag takes exactly one
+// argument, which may be given inline after `=`, boolean flags take no argument unless
+// it is given inline (e.g. `--verbose=false`), flags with NoOptDefVal use it if no value
+// is given inline, shorthands may be stacked and the last one may be followed by its value
+// (e.g. `-vn5`). A scalar flag provided more than once keeps the last value, while
+// slices and counters accumulate the values.
+package pflag
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/flagset"
+)
+
+const (
+	longPrefix     = "--"
+	shortPrefix    = "-"
+	terminator     = "--"
+	valueSeparator = "="
+)
+
+// Value is the interface to the value of the flag, as it is defined by pflag.
+type Value interface {
+	String() string
+	Set(string) error
+	Type() string
+}
+
+// Flag describes the flag of the FlagSet.
+type Flag struct {
+	Name        string
+	Shorthand   string
+	Usage       string
+	DefValue    string
+	NoOptDefVal string
+	Changed     bool
+	Value       Value
+}
+
+// FlagSet is the set of flags backed by the helium FlagSet.
+type FlagSet struct {
+	name   string
+	b      *flagset.Builder
+	flags  []*Flag
+	args   []string
+	parsed bool
+}
+
+// NewFlagSet creates an empty flag set with the given name.
+func NewFlagSet(name string) *FlagSet {
+	return &FlagSet{name: name, b: flagset.New()}
+}
+
+// Name returns the name of the flag set.
+func (fs *FlagSet) Name() string {
+	return fs.name
+}
+
+// FlagSet returns the underlying helium FlagSet.
+func (fs *FlagSet) FlagSet() *flagset.FlagSet {
+	return fs.b.Build()
+}
+
+// Lookup returns the flag with the given name, or nil if there is no such flag.
+func (fs *FlagSet) Lookup(name string) *Flag {
+	idx := slices.IndexFunc(fs.flags, func(f *Flag) bool { return f.Name == name })
+	if idx == -1 {
+		return nil
+	}
+	return fs.flags[idx]
+}
+
+// ShorthandLookup returns the flag with the given shorthand, or nil if there is no such flag.
+func (fs *FlagSet) ShorthandLookup(shorthand string) *Flag {
+	if shorthand == "" {
+		return nil
+	}
+	idx := slices.IndexFunc(fs.flags, func(f *Flag) bool { return f.Shorthand == shorthand })
+	if idx == -1 {
+		return nil
+	}
+	return fs.flags[idx]
+}
+
+// Changed reports whether the flag with the given name was set explicitly.
+func (fs *FlagSet) Changed(name string) bool {
+	f := fs.Lookup(name)
+	return f != nil && f.Changed
+}
+
+// Set sets the value of the flag with the given name, as if it was given on the command line.
+func (fs *FlagSet) Set(name, value string) error {
+	f := fs.Lookup(name)
+	if f == nil {
+		return errors.UnknownFlag(name)
+	}
+	if err := f.Value.Set(value); err != nil {
+		return err
+	}
+	f.Changed = true
+	return nil
+}
+
+// VisitAll calls fn for each flag in the order they were defined.
+func (fs *FlagSet) VisitAll(fn func(*Flag)) {
+	for _, f := range fs.flags {
+		fn(f)
+	}
+}
+
+// Visit calls fn for each flag which was set, in the order they were defined.
+func (fs *FlagSet) Visit(fn func(*Flag)) {
+	for _, f := range fs.flags {
+		if f.Changed {
+			fn(f)
+		}
+	}
+}
+
+// Parsed reports whether Parse has been called.
+func (fs *FlagSet) Parsed() bool {
+	return fs.parsed
+}
+
+// Args returns the positional arguments.
+func (fs *FlagSet) Args() []string {
+	return fs.args
+}
+
+// NArg returns the number of positional arguments.
+func (fs *FlagSet) NArg() int {
+	return len(fs.args)
+}
+
+// Arg returns the i-th positional argument, or an empty string if there is no such argument.
+func (fs *FlagSet) Arg(i int) string {
+	if i < 0 || i >= len(fs.args) {
+		return ""
+	}
+	return fs.args[i]
+}
+
+// Parse parses the arguments, which should not include the command name.
+// Positional arguments are available with Args after parsing.
+func (fs *FlagSet) Parse(arguments []string) error {
+	fs.parsed = true
+	fs.args = make([]string, 0, len(arguments))
+	var set []setting
+	for i := 0; i < len(arguments); i++ {
+		var (
+			arg = arguments[i]
+			err error
+		)
+		switch {
+		case arg == terminator:
+			fs.args = append(fs.args, arguments[i+1:]...)
+			i = len(arguments)
+		case strings.HasPrefix(arg, longPrefix):
+			set, i, err = fs.parseLong(set, arguments, i)
+		case strings.HasPrefix(arg, shortPrefix) && len(arg) > 1:
+			set, i, err = fs.parseShort(set, arguments, i)
+		default:
+			fs.args = append(fs.args, arg)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return fs.apply(set)
+}
+
+// setting is the value given for the flag on the command line.
+type setting struct {
+	flag  *Flag
+	value string
+}
+
+func (fs *FlagSet) parseLong(set []setting, args []string, i int) ([]setting, int, error) {
+	name, value, inline := strings.Cut(strings.TrimPrefix(args[i], longPrefix), valueSeparator)
+	f := fs.Lookup(name)
+	if f == nil {
+		return nil, i, errors.UnknownFlag(name)
+	}
+	switch {
+	case inline:
+	case f.NoOptDefVal != "":
+		value = f.NoOptDefVal
+	case i+1 < len(args):
+		i++
+		value = args[i]
+	default:
+		return nil, i, errors.NoValueProvided(name)
+	}
+	return append(set, setting{f, value}), i, nil
+}
+
+func (fs *FlagSet) parseShort(set []setting, args []string, i int) ([]setting, int, error) {
+	shorthands := strings.TrimPrefix(args[i], shortPrefix)
+	for len(shorthands) > 0 {
+		s := shorthands[:1]
+		shorthands = shorthands[1:]
+		f := fs.ShorthandLookup(s)
+		if f == nil {
+			return nil, i, errors.UnknownShorthand(s)
+		}
+		var value string
+		switch {
+		case strings.HasPrefix(shorthands, valueSeparator):
+			value, shorthands = shorthands[1:], ""
+		case f.NoOptDefVal != "":
+			value = f.NoOptDefVal
+		case shorthands != "":
+			value, shorthands = shorthands, ""
+		case i+1 < len(args):
+			i++
+			value = args[i]
+		default:
+			return nil, i, errors.NoValueProvided(f.Name)
+		}
+		set = append(set, setting{f, value})
+	}
+	return set, i, nil
+}
+
+// apply passes the settings to the helium FlagSet.
+func (fs *FlagSet) apply(set []setting) error {
+	args := make([]string, 0, len(set))
+	for _, s := range set {
+		if s.value == countIncrement {
+			args = append(args, longPrefix+s.flag.Name)
+			continue
+		}
+		args = append(args, longPrefix+s.flag.Name+valueSeparator+s.value)
+	}
+	if err := fs.FlagSet().Parse(args); err != nil {
+		return err
+	}
+	for _, s := range set {
+		if v, ok := s.flag.Value.(*value); ok {
+			v.sync()
+		}
+		s.flag.Changed = true
+	}
+	return nil
+}
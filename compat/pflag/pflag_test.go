@@ -0,0 +1,197 @@
+package pflag
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/flagset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pflagTest struct {
+	name        string
+	input       []string
+	expected    func(t *testing.T, fs *FlagSet)
+	err         bool
+	expectedErr error
+}
+
+func newTestFlagSet() *FlagSet {
+	fs := NewFlagSet("test")
+	fs.StringP("name", "n", "guest", "user name")
+	fs.BoolP("verbose", "v", false, "verbose output")
+	fs.Bool("color", true, "colored output")
+	fs.IntP("port", "p", 80, "listen port")
+	fs.Duration("timeout", time.Second, "timeout")
+	fs.StringSliceP("tag", "t", nil, "tags")
+	fs.CountP("debug", "d", "debug level")
+	fs.String("mode", "auto", "mode")
+	fs.Lookup("mode").NoOptDefVal = "always"
+	return fs
+}
+
+func TestFlagSet_Parse(t *testing.T) {
+	t.Parallel()
+	tests := []pflagTest{
+		{
+			name:  "defaults",
+			input: []string{},
+			expected: func(t *testing.T, fs *FlagSet) {
+				assert.Equal(t, "guest", fs.Lookup("name").Value.String())
+				assert.False(t, fs.Changed("name"))
+				assert.Equal(t, 0, fs.NArg())
+			},
+		},
+		{
+			name:  "inline values",
+			input: []string{"--name=foo=bar", "--port=8080", "--timeout=1m"},
+			expected: func(t *testing.T, fs *FlagSet) {
+				assert.Equal(t, "foo=bar", fs.Lookup("name").Value.String())
+				assert.Equal(t, "8080", fs.Lookup("port").Value.String())
+				assert.Equal(t, "1m0s", fs.Lookup("timeout").Value.String())
+				assert.True(t, fs.Changed("port"))
+			},
+		},
+		{
+			name:  "bool negation",
+			input: []string{"--color=false", "--verbose", "false"},
+			expected: func(t *testing.T, fs *FlagSet) {
+				assert.Equal(t, "false", fs.Lookup("color").Value.String())
+				assert.Equal(t, "true", fs.Lookup("verbose").Value.String())
+				assert.Equal(t, []string{"false"}, fs.Args())
+			},
+		},
+		{
+			name:  "no option default value",
+			input: []string{"--mode", "positional", "--name", "foo"},
+			expected: func(t *testing.T, fs *FlagSet) {
+				assert.Equal(t, "always", fs.Lookup("mode").Value.String())
+				assert.Equal(t, "foo", fs.Lookup("name").Value.String())
+				assert.Equal(t, []string{"positional"}, fs.Args())
+			},
+		},
+		{
+			name:  "no option default value overridden inline",
+			input: []string{"--mode=never"},
+			expected: func(t *testing.T, fs *FlagSet) {
+				assert.Equal(t, "never", fs.Lookup("mode").Value.String())
+			},
+		},
+		{
+			name:  "shorthands",
+			input: []string{"-vddp8080", "-n", "foo", "-t=a", "-t", "b,c", "-d"},
+			expected: func(t *testing.T, fs *FlagSet) {
+				assert.Equal(t, "true", fs.Lookup("verbose").Value.String())
+				assert.Equal(t, "3", fs.Lookup("debug").Value.String())
+				assert.Equal(t, "8080", fs.Lookup("port").Value.String())
+				assert.Equal(t, "foo", fs.Lookup("name").Value.String())
+				assert.Equal(t, "a,b,c", fs.Lookup("tag").Value.String())
+			},
+		},
+		{
+			name:  "positionals and terminator",
+			input: []string{"first", "--port", "1", "-", "second", "--", "--name", "third"},
+			expected: func(t *testing.T, fs *FlagSet) {
+				assert.Equal(t, []string{"first", "-", "second", "--name", "third"}, fs.Args())
+				assert.Equal(t, 5, fs.NArg())
+				assert.Equal(t, "second", fs.Arg(2))
+				assert.Equal(t, "", fs.Arg(5))
+				assert.False(t, fs.Changed("name"))
+			},
+		},
+		{
+			name:  "last value wins",
+			input: []string{"--port", "1", "--port=2"},
+			expected: func(t *testing.T, fs *FlagSet) {
+				assert.Equal(t, "2", fs.Lookup("port").Value.String())
+			},
+		},
+		{
+			name:        "unknown flag",
+			input:       []string{"--unknown"},
+			err:         true,
+			expectedErr: errors.ErrUnknownFlag,
+		},
+		{
+			name:        "unknown shorthand",
+			input:       []string{"-vx"},
+			err:         true,
+			expectedErr: errors.ErrUnknownShorthand,
+		},
+		{
+			name:        "missing value",
+			input:       []string{"--name"},
+			err:         true,
+			expectedErr: errors.ErrNoValueProvided,
+		},
+		{
+			name:        "invalid value",
+			input:       []string{"--port=http"},
+			err:         true,
+			expectedErr: errors.ErrParseFailed,
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			fs := newTestFlagSet()
+			err := fs.Parse(tt.input)
+			assert.True(t, fs.Parsed())
+			if tt.err {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.expected(t, fs)
+		})
+	}
+}
+
+func TestFlagSet_Vars(t *testing.T) {
+	t.Parallel()
+	var (
+		name    string
+		verbose bool
+		tags    []string
+		level   int
+	)
+	fs := NewFlagSet("test")
+	fs.StringVarP(&name, "name", "n", "guest", "user name")
+	fs.BoolVar(&verbose, "verbose", false, "verbose output")
+	fs.StringSliceVar(&tags, "tag", []string{"default"}, "tags")
+	fs.CountVarP(&level, "level", "l", "level")
+	port := fs.Int("port", 80, "listen port")
+
+	assert.Equal(t, "guest", name)
+	assert.Equal(t, []string{"default"}, tags)
+	assert.Equal(t, 80, *port)
+
+	require.NoError(t, fs.Parse([]string{"-n", "foo", "--verbose", "--tag", "a", "--tag=b", "-ll", "--port", "8080"}))
+	assert.Equal(t, "foo", name)
+	assert.True(t, verbose)
+	assert.Equal(t, []string{"a", "b"}, tags)
+	assert.Equal(t, 2, level)
+	assert.Equal(t, 8080, *port)
+
+	color := fs.Bool("color", true, "colored output")
+	require.NoError(t, fs.Set("color", "false"))
+	assert.False(t, *color)
+	assert.True(t, fs.Changed("color"))
+	require.NoError(t, fs.Set("verbose", "false"))
+	assert.False(t, verbose)
+	assert.ErrorIs(t, fs.Set("missing", "1"), errors.ErrUnknownFlag)
+	assert.Equal(t, "foo", flagset.GetString(fs.FlagSet(), "name"))
+
+	var visited []string
+	fs.VisitAll(func(f *Flag) { visited = append(visited, f.Name+":"+f.Value.Type()) })
+	assert.Equal(t, []string{"name:string", "verbose:bool", "tag:stringSlice", "level:count", "port:int", "color:bool"}, visited)
+	var changed []string
+	fs.Visit(func(f *Flag) { changed = append(changed, f.Name) })
+	assert.Equal(t, []string{"name", "verbose", "tag", "level", "port", "color"}, changed)
+	assert.Equal(t, "l", fs.ShorthandLookup("l").Shorthand)
+	assert.Equal(t, "default", fs.Lookup("tag").DefValue)
+}
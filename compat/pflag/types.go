@@ -0,0 +1,284 @@
+package pflag
+
+import (
+	"time"
+
+	"github.com/brongineer/helium/flag"
+	"github.com/brongineer/helium/flagset"
+)
+
+// countIncrement is the NoOptDefVal of count flags, which increments the counter.
+const countIncrement = "+1"
+
+// value exposes the helium flag through the pflag Value interface.
+type value struct {
+	f    flagset.Flag
+	typ  string
+	sync func()
+}
+
+func (v *value) String() string {
+	return v.f.String()
+}
+
+func (v *value) Set(s string) error {
+	if err := v.f.FromCommandLine(s); err != nil {
+		return err
+	}
+	v.sync()
+	return nil
+}
+
+func (v *value) Type() string {
+	return v.typ
+}
+
+func options(shorthand, usage string, defaultValue any, policy flag.RepeatPolicy) []flag.Option {
+	opts := []flag.Option{flag.Description(usage), flag.DefaultValue(defaultValue), flag.Repeat(policy)}
+	if shorthand != "" {
+		opts = append(opts, flag.Shorthand(shorthand))
+	}
+	return opts
+}
+
+// define binds the helium flag to the flag set and keeps the variable p
+// in sync with the value of the flag.
+func define[T any](fs *FlagSet, p *T, f flagset.Flag, shorthand, usage, typ string) *Flag {
+	sync := func() {
+		*p = flag.DerefOrDie[T](f.Value())
+	}
+	sync()
+	fs.b.BindFlag(f)
+	fl := &Flag{
+		Name:      f.Name(),
+		Shorthand: shorthand,
+		Usage:     usage,
+		DefValue:  f.String(),
+		Value:     &value{f: f, typ: typ, sync: sync},
+	}
+	fs.flags = append(fs.flags, fl)
+	return fl
+}
+
+// StringVarP defines the string flag with the shorthand, storing its value in p.
+func (fs *FlagSet) StringVarP(p *string, name, shorthand string, value string, usage string) {
+	define(fs, p, flag.String(name, options(shorthand, usage, value, flag.RepeatLastWins)...), shorthand, usage, "string")
+}
+
+// StringVar defines the string flag, storing its value in p.
+func (fs *FlagSet) StringVar(p *string, name string, value string, usage string) {
+	fs.StringVarP(p, name, "", value, usage)
+}
+
+// StringP defines the string flag with the shorthand and returns the pointer to its value.
+func (fs *FlagSet) StringP(name, shorthand string, value string, usage string) *string {
+	p := new(string)
+	fs.StringVarP(p, name, shorthand, value, usage)
+	return p
+}
+
+// String defines the string flag and returns the pointer to its value.
+func (fs *FlagSet) String(name string, value string, usage string) *string {
+	return fs.StringP(name, "", value, usage)
+}
+
+// BoolVarP defines the bool flag with the shorthand, storing its value in p.
+func (fs *FlagSet) BoolVarP(p *bool, name, shorthand string, value bool, usage string) {
+	f := define(fs, p, flag.Bool(name, options(shorthand, usage, value, flag.RepeatLastWins)...), shorthand, usage, "bool")
+	f.NoOptDefVal = "true"
+}
+
+// BoolVar defines the bool flag, storing its value in p.
+func (fs *FlagSet) BoolVar(p *bool, name string, value bool, usage string) {
+	fs.BoolVarP(p, name, "", value, usage)
+}
+
+// BoolP defines the bool flag with the shorthand and returns the pointer to its value.
+func (fs *FlagSet) BoolP(name, shorthand string, value bool, usage string) *bool {
+	p := new(bool)
+	fs.BoolVarP(p, name, shorthand, value, usage)
+	return p
+}
+
+// Bool defines the bool flag and returns the pointer to its value.
+func (fs *FlagSet) Bool(name string, value bool, usage string) *bool {
+	return fs.BoolP(name, "", value, usage)
+}
+
+// IntVarP defines the int flag with the shorthand, storing its value in p.
+func (fs *FlagSet) IntVarP(p *int, name, shorthand string, value int, usage string) {
+	define(fs, p, flag.Int(name, options(shorthand, usage, value, flag.RepeatLastWins)...), shorthand, usage, "int")
+}
+
+// IntVar defines the int flag, storing its value in p.
+func (fs *FlagSet) IntVar(p *int, name string, value int, usage string) {
+	fs.IntVarP(p, name, "", value, usage)
+}
+
+// IntP defines the int flag with the shorthand and returns the pointer to its value.
+func (fs *FlagSet) IntP(name, shorthand string, value int, usage string) *int {
+	p := new(int)
+	fs.IntVarP(p, name, shorthand, value, usage)
+	return p
+}
+
+// Int defines the int flag and returns the pointer to its value.
+func (fs *FlagSet) Int(name string, value int, usage string) *int {
+	return fs.IntP(name, "", value, usage)
+}
+
+// Int64VarP defines the int64 flag with the shorthand, storing its value in p.
+func (fs *FlagSet) Int64VarP(p *int64, name, shorthand string, value int64, usage string) {
+	define(fs, p, flag.Int64(name, options(shorthand, usage, value, flag.RepeatLastWins)...), shorthand, usage, "int64")
+}
+
+// Int64Var defines the int64 flag, storing its value in p.
+func (fs *FlagSet) Int64Var(p *int64, name string, value int64, usage string) {
+	fs.Int64VarP(p, name, "", value, usage)
+}
+
+// Int64P defines the int64 flag with the shorthand and returns the pointer to its value.
+func (fs *FlagSet) Int64P(name, shorthand string, value int64, usage string) *int64 {
+	p := new(int64)
+	fs.Int64VarP(p, name, shorthand, value, usage)
+	return p
+}
+
+// Int64 defines the int64 flag and returns the pointer to its value.
+func (fs *FlagSet) Int64(name string, value int64, usage string) *int64 {
+	return fs.Int64P(name, "", value, usage)
+}
+
+// UintVarP defines the uint flag with the shorthand, storing its value in p.
+func (fs *FlagSet) UintVarP(p *uint, name, shorthand string, value uint, usage string) {
+	define(fs, p, flag.Uint(name, options(shorthand, usage, value, flag.RepeatLastWins)...), shorthand, usage, "uint")
+}
+
+// UintVar defines the uint flag, storing its value in p.
+func (fs *FlagSet) UintVar(p *uint, name string, value uint, usage string) {
+	fs.UintVarP(p, name, "", value, usage)
+}
+
+// UintP defines the uint flag with the shorthand and returns the pointer to its value.
+func (fs *FlagSet) UintP(name, shorthand string, value uint, usage string) *uint {
+	p := new(uint)
+	fs.UintVarP(p, name, shorthand, value, usage)
+	return p
+}
+
+// Uint defines the uint flag and returns the pointer to its value.
+func (fs *FlagSet) Uint(name string, value uint, usage string) *uint {
+	return fs.UintP(name, "", value, usage)
+}
+
+// Float64VarP defines the float64 flag with the shorthand, storing its value in p.
+func (fs *FlagSet) Float64VarP(p *float64, name, shorthand string, value float64, usage string) {
+	define(fs, p, flag.Float64(name, options(shorthand, usage, value, flag.RepeatLastWins)...), shorthand, usage, "float64")
+}
+
+// Float64Var defines the float64 flag, storing its value in p.
+func (fs *FlagSet) Float64Var(p *float64, name string, value float64, usage string) {
+	fs.Float64VarP(p, name, "", value, usage)
+}
+
+// Float64P defines the float64 flag with the shorthand and returns the pointer to its value.
+func (fs *FlagSet) Float64P(name, shorthand string, value float64, usage string) *float64 {
+	p := new(float64)
+	fs.Float64VarP(p, name, shorthand, value, usage)
+	return p
+}
+
+// Float64 defines the float64 flag and returns the pointer to its value.
+func (fs *FlagSet) Float64(name string, value float64, usage string) *float64 {
+	return fs.Float64P(name, "", value, usage)
+}
+
+// DurationVarP defines the duration flag with the shorthand, storing its value in p.
+func (fs *FlagSet) DurationVarP(p *time.Duration, name, shorthand string, value time.Duration, usage string) {
+	define(fs, p, flag.Duration(name, options(shorthand, usage, value, flag.RepeatLastWins)...), shorthand, usage, "duration")
+}
+
+// DurationVar defines the duration flag, storing its value in p.
+func (fs *FlagSet) DurationVar(p *time.Duration, name string, value time.Duration, usage string) {
+	fs.DurationVarP(p, name, "", value, usage)
+}
+
+// DurationP defines the duration flag with the shorthand and returns the pointer to its value.
+func (fs *FlagSet) DurationP(name, shorthand string, value time.Duration, usage string) *time.Duration {
+	p := new(time.Duration)
+	fs.DurationVarP(p, name, shorthand, value, usage)
+	return p
+}
+
+// Duration defines the duration flag and returns the pointer to its value.
+func (fs *FlagSet) Duration(name string, value time.Duration, usage string) *time.Duration {
+	return fs.DurationP(name, "", value, usage)
+}
+
+// StringSliceVarP defines the stringSlice flag with the shorthand, storing its value in p.
+func (fs *FlagSet) StringSliceVarP(p *[]string, name, shorthand string, value []string, usage string) {
+	define(fs, p, flag.StringSlice(name, options(shorthand, usage, value, flag.RepeatAppend)...), shorthand, usage, "stringSlice")
+}
+
+// StringSliceVar defines the stringSlice flag, storing its value in p.
+func (fs *FlagSet) StringSliceVar(p *[]string, name string, value []string, usage string) {
+	fs.StringSliceVarP(p, name, "", value, usage)
+}
+
+// StringSliceP defines the stringSlice flag with the shorthand and returns the pointer to its value.
+func (fs *FlagSet) StringSliceP(name, shorthand string, value []string, usage string) *[]string {
+	p := new([]string)
+	fs.StringSliceVarP(p, name, shorthand, value, usage)
+	return p
+}
+
+// StringSlice defines the stringSlice flag and returns the pointer to its value.
+func (fs *FlagSet) StringSlice(name string, value []string, usage string) *[]string {
+	return fs.StringSliceP(name, "", value, usage)
+}
+
+// IntSliceVarP defines the intSlice flag with the shorthand, storing its value in p.
+func (fs *FlagSet) IntSliceVarP(p *[]int, name, shorthand string, value []int, usage string) {
+	define(fs, p, flag.IntSlice(name, options(shorthand, usage, value, flag.RepeatAppend)...), shorthand, usage, "intSlice")
+}
+
+// IntSliceVar defines the intSlice flag, storing its value in p.
+func (fs *FlagSet) IntSliceVar(p *[]int, name string, value []int, usage string) {
+	fs.IntSliceVarP(p, name, "", value, usage)
+}
+
+// IntSliceP defines the intSlice flag with the shorthand and returns the pointer to its value.
+func (fs *FlagSet) IntSliceP(name, shorthand string, value []int, usage string) *[]int {
+	p := new([]int)
+	fs.IntSliceVarP(p, name, shorthand, value, usage)
+	return p
+}
+
+// IntSlice defines the intSlice flag and returns the pointer to its value.
+func (fs *FlagSet) IntSlice(name string, value []int, usage string) *[]int {
+	return fs.IntSliceP(name, "", value, usage)
+}
+
+// CountVarP defines the count flag with the shorthand, storing its value in p.
+// Every occurrence of the flag without a value increments the counter.
+func (fs *FlagSet) CountVarP(p *int, name, shorthand, usage string) {
+	f := define(fs, p, flag.Counter(name, options(shorthand, usage, 0, flag.RepeatAppend)...), shorthand, usage, "count")
+	f.NoOptDefVal = countIncrement
+}
+
+// CountVar defines the count flag, storing its value in p.
+func (fs *FlagSet) CountVar(p *int, name, usage string) {
+	fs.CountVarP(p, name, "", usage)
+}
+
+// CountP defines the count flag with the shorthand and returns the pointer to its value.
+func (fs *FlagSet) CountP(name, shorthand, usage string) *int {
+	p := new(int)
+	fs.CountVarP(p, name, shorthand, usage)
+	return p
+}
+
+// Count defines the count flag and returns the pointer to its value.
+func (fs *FlagSet) Count(name, usage string) *int {
+	return fs.CountP(name, "", usage)
+}
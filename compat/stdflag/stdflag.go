@@ -0,0 +1,122 @@
+// Package stdflag provides interoperability with the flag package of the standard library.
+// It allows to parse flags registered with the standard library, e.g. by third-party packages
+// on flag.CommandLine, with a helium FlagSet, and to expose helium flags to the code which
+// looks them up in the standard library flag set. Build the helium FlagSet with
+// flagset.ParseModeGo to keep the single-dash syntax of the standard library, e.g. `-name value`.
+package stdflag
+
+import (
+	goflag "flag"
+
+	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/flag"
+	"github.com/brongineer/helium/flagset"
+	"github.com/brongineer/helium/parser"
+)
+
+// boolFlag mirrors the optional interface of the standard library flag values
+// which do not require an argument.
+type boolFlag interface {
+	IsBoolFlag() bool
+}
+
+func isBoolFlag(v any) bool {
+	b, ok := v.(boolFlag)
+	return ok && b.IsBoolFlag()
+}
+
+// valueParser passes the input to the Set method of the standard library flag value.
+// The flag holds the string representation of the value after the input is set.
+// Like the standard library, it accepts repeated values and an empty command-line
+// input for boolean values.
+type valueParser struct {
+	value goflag.Value
+}
+
+func (p valueParser) Parse(ctx parser.Context, input string) (any, error) {
+	var empty string
+	if input == empty && ctx.Source == parser.SourceCommandLine {
+		if !isBoolFlag(p.value) {
+			return nil, errors.ErrNoValueProvided
+		}
+		input = "true"
+	}
+	if err := p.value.Set(input); err != nil {
+		return nil, err
+	}
+	s := p.value.String()
+	return &s, nil
+}
+
+// FromStd creates a helium flag backed by the standard library flag. The helium flag
+// keeps the name, the usage as the description and the default value of the standard
+// library flag, and holds the string representation of its value, so it may be read
+// with flagset.GetString. Parsed input is set to the standard library flag value, hence
+// the variables bound to the standard library flag are updated as well. Flags with
+// single-letter names also get the same shorthand. Like in the standard library, boolean
+// flags take no separate argument and are set to true unless the value is given inline.
+func FromStd(f *goflag.Flag) *flag.TypedFlag[string] {
+	opts := []flag.Option{
+		flag.Description(f.Usage),
+		flag.DefaultValue(f.DefValue),
+		flag.ContextParser(valueParser{f.Value}),
+	}
+	if isBoolFlag(f.Value) {
+		opts = append(opts, flag.NoArgValue("true"))
+	}
+	if len(f.Name) == 1 {
+		opts = append(opts, flag.Shorthand(f.Name))
+	}
+	return flag.Typed[string](f.Name, opts...)
+}
+
+// Import binds all flags of the standard library flag set to the builder.
+// It returns the builder, so that the call may be chained.
+func Import(b *flagset.Builder, set *goflag.FlagSet) *flagset.Builder {
+	set.VisitAll(func(f *goflag.Flag) {
+		b.BindFlag(FromStd(f))
+	})
+	return b
+}
+
+// value exposes the helium flag as the standard library flag value.
+type value struct {
+	f flagset.Flag
+}
+
+func (v *value) String() string {
+	if v.f == nil {
+		return ""
+	}
+	return v.f.String()
+}
+
+func (v *value) Set(s string) error {
+	return v.f.FromCommandLine(s)
+}
+
+func (v *value) IsBoolFlag() bool {
+	if v.f == nil {
+		return false
+	}
+	_, ok := v.f.Value().(*bool)
+	return ok
+}
+
+// ToStd returns the standard library flag value backed by the helium flag.
+// Setting the value parses the input as a command-line argument of the helium flag.
+func ToStd(f flagset.Flag) goflag.Value {
+	return &value{f}
+}
+
+// Export defines all flags of the helium flag set in the standard library flag set.
+// Flags already defined in the standard library flag set are skipped, so that flags
+// imported from there earlier are not defined twice.
+func Export(fs *flagset.FlagSet, set *goflag.FlagSet) {
+	fs.VisitAll(func(f flagset.Flag) {
+		if set.Lookup(f.Name()) != nil {
+			return
+		}
+		set.Var(ToStd(f), f.Name(), f.Description())
+	})
+}
@@ -0,0 +1,93 @@
+package stdflag
+
+import (
+	goflag "flag"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/flag"
+	"github.com/brongineer/helium/flagset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type listValue []string
+
+func (l *listValue) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listValue) Set(s string) error {
+	*l = append(*l, s)
+	return nil
+}
+
+func TestImport(t *testing.T) {
+	t.Parallel()
+	set := goflag.NewFlagSet("test", goflag.ContinueOnError)
+	verbosity := set.Int("v", 0, "log level for V logs")
+	logToStderr := set.Bool("logtostderr", false, "log to standard error")
+	timeout := set.Duration("timeout", time.Second, "request timeout")
+	var list listValue
+	set.Var(&list, "vmodule", "per-module verbosity")
+
+	fs := Import(flagset.New(), set).
+		BindFlag(flag.String("name")).
+		Build()
+	require.NoError(t, fs.Parse([]string{"-v", "2", "--logtostderr", "--vmodule", "a=1", "--vmodule", "b=2", "--name", "x"}))
+
+	assert.Equal(t, 2, *verbosity)
+	assert.True(t, *logToStderr)
+	assert.Equal(t, time.Second, *timeout)
+	assert.Equal(t, listValue{"a=1", "b=2"}, list)
+	assert.Equal(t, "2", flagset.GetString(fs, "v"))
+	assert.Equal(t, "1s", flagset.GetString(fs, "timeout"))
+	assert.Equal(t, "a=1,b=2", flagset.GetString(fs, "vmodule"))
+	assert.Equal(t, "log to standard error", fs.Lookup("logtostderr").Description())
+}
+
+func TestImport_BoolFlagBeforePositional(t *testing.T) {
+	t.Parallel()
+	for mode, prefix := range map[flagset.ParseMode]string{flagset.ParseModeDefault: "--", flagset.ParseModeGo: "-"} {
+		set := goflag.NewFlagSet("test", goflag.ContinueOnError)
+		logToStderr := set.Bool("logtostderr", false, "log to standard error")
+		alsoToStderr := set.Bool("alsologtostderr", true, "log to standard error as well")
+		fs := Import(flagset.New().ParseMode(mode), set).Build()
+		args := []string{prefix + "logtostderr", "input.txt", prefix + "alsologtostderr=false", "output.txt"}
+		require.NoError(t, fs.Parse(args))
+		assert.True(t, *logToStderr)
+		assert.False(t, *alsoToStderr)
+		assert.Equal(t, "true", flagset.GetString(fs, "logtostderr"))
+	}
+}
+
+func TestImport_Errors(t *testing.T) {
+	t.Parallel()
+	set := goflag.NewFlagSet("test", goflag.ContinueOnError)
+	set.Int("count", 0, "")
+	fs := Import(flagset.New(), set).Build()
+	assert.ErrorIs(t, fs.Parse([]string{"--count"}), errors.ErrNoValueProvided)
+	assert.ErrorIs(t, fs.Parse([]string{"--count", "many"}), errors.ErrParseFailed)
+}
+
+func TestExport(t *testing.T) {
+	t.Parallel()
+	fs := flagset.New().
+		BindFlag(flag.String("name", flag.Description("user name"), flag.DefaultValue("guest"))).
+		BindFlag(flag.Bool("verbose")).
+		BindFlag(flag.IntSlice("ports")).
+		Build()
+	set := goflag.NewFlagSet("test", goflag.ContinueOnError)
+	set.String("name", "", "defined before export")
+	Export(fs, set)
+
+	assert.Equal(t, "defined before export", set.Lookup("name").Usage)
+	require.NotNil(t, set.Lookup("verbose"))
+	require.NoError(t, set.Parse([]string{"-verbose", "-ports", "80,443"}))
+	assert.True(t, flagset.GetBool(fs, "verbose"))
+	assert.Equal(t, []int{80, 443}, flagset.GetIntSlice(fs, "ports"))
+	assert.Equal(t, "80,443", set.Lookup("ports").Value.String())
+	assert.Equal(t, "guest", ToStd(fs.Lookup("name")).String())
+}
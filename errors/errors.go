@@ -3,6 +3,8 @@ package errors
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 )
 
 const (
@@ -15,6 +17,17 @@ const (
 	noParserDefinedMessage  = "no input parser defined for flag"
 	cmdParserNotImplemented = "command-line parser is not implemented"
 	envParserNotImplemented = "env variable parser is not implemented"
+	invalidKeyValueMessage  = "invalid key=value pair"
+	duplicateKeyMessage     = "duplicate key"
+	invalidChoiceMessage    = "invalid choice"
+	invalidValueMessage     = "invalid value"
+	ambiguousFlagMessage    = "ambiguous flag"
+	responseFileMessage     = "failed to read response file"
+	responseFileDepth       = "response files are nested too deep"
+	unterminatedQuote       = "unterminated quote"
+	valueFileMessage        = "failed to read flag value from file"
+	valueFileRepeated       = "value read from file cannot be combined with repeated values"
+	redactedValue           = "[REDACTED]"
 )
 
 var (
@@ -27,14 +40,60 @@ var (
 	ErrNoParserDefined           = errors.New(noParserDefinedMessage)
 	ErrCmdParserIsNotImplemented = errors.New(cmdParserNotImplemented)
 	ErrEnvParserIsNotImplemented = errors.New(envParserNotImplemented)
+	ErrInvalidKeyValue           = errors.New(invalidKeyValueMessage)
+	ErrDuplicateKey              = errors.New(duplicateKeyMessage)
+	ErrInvalidChoice             = errors.New(invalidChoiceMessage)
+	ErrInvalidValue              = errors.New(invalidValueMessage)
+	ErrAmbiguousFlag             = errors.New(ambiguousFlagMessage)
+	ErrResponseFile              = errors.New(responseFileMessage)
+	ErrResponseFileDepth         = errors.New(responseFileDepth)
+	ErrUnterminatedQuote         = errors.New(unterminatedQuote)
+	ErrValueFile                 = errors.New(valueFileMessage)
+	ErrValueFileRepeated         = errors.New(valueFileRepeated)
 )
 
-func UnknownFlag(flagName string) error {
-	return fmt.Errorf("%s: %w", flagName, ErrUnknownFlag)
+// UnknownFlagError is returned if there is no flag with the given name or shorthand.
+// Suggestions hold the names of the existing flags, or the shorthands, similar to the unknown one.
+// SingleDash reports that the flag names are prefixed with a single dash, as in Go-style parse mode.
+// It matches ErrUnknownFlag or ErrUnknownShorthand with errors.Is.
+type UnknownFlagError struct {
+	Name        string
+	Shorthand   bool
+	SingleDash  bool
+	Suggestions []string
 }
 
-func UnknownShorthand(shorthandName string) error {
-	return fmt.Errorf("%s: %w", shorthandName, ErrUnknownShorthand)
+func (e *UnknownFlagError) Error() string {
+	var (
+		msg    = fmt.Sprintf("%s: %s", e.Name, e.Unwrap())
+		prefix = "--"
+	)
+	if e.Shorthand || e.SingleDash {
+		prefix = "-"
+	}
+	if len(e.Suggestions) == 0 {
+		return msg
+	}
+	suggestions := make([]string, 0, len(e.Suggestions))
+	for _, s := range e.Suggestions {
+		suggestions = append(suggestions, prefix+s)
+	}
+	return fmt.Sprintf("%s, did you mean %s?", msg, strings.Join(suggestions, " or "))
+}
+
+func (e *UnknownFlagError) Unwrap() error {
+	if e.Shorthand {
+		return ErrUnknownShorthand
+	}
+	return ErrUnknownFlag
+}
+
+func UnknownFlag(flagName string, suggestions ...string) error {
+	return &UnknownFlagError{Name: flagName, Suggestions: suggestions}
+}
+
+func UnknownShorthand(shorthandName string, suggestions ...string) error {
+	return &UnknownFlagError{Name: shorthandName, Shorthand: true, Suggestions: suggestions}
 }
 
 func FlagVisited(flagName string) error {
@@ -56,3 +115,80 @@ func TypeMismatch(actual, expected any) error {
 func NoParserDefined(flagName string) error {
 	return fmt.Errorf("%s: %w", flagName, ErrNoParserDefined)
 }
+
+func InvalidKeyValue(pair string) error {
+	return fmt.Errorf("%q: %w", pair, ErrInvalidKeyValue)
+}
+
+func DuplicateKey(key string) error {
+	return fmt.Errorf("%s: %w", key, ErrDuplicateKey)
+}
+
+func InvalidChoice(value string, choices []string) error {
+	return fmt.Errorf("%q: %w, valid choices are: %s", value, ErrInvalidChoice, strings.Join(choices, ", "))
+}
+
+func InvalidValue(value, reason string) error {
+	return fmt.Errorf("%q: %w: %s", value, ErrInvalidValue, reason)
+}
+
+func AmbiguousFlag(flagName string, candidates []string) error {
+	return fmt.Errorf("%s: %w, candidates are: %s", flagName, ErrAmbiguousFlag, strings.Join(candidates, ", "))
+}
+
+func ResponseFile(path string, err error) error {
+	return errors.Join(fmt.Errorf("%s: %w", path, ErrResponseFile), err)
+}
+
+func ResponseFileDepth(path string, limit int) error {
+	return fmt.Errorf("%s: %w, the limit is %d", path, ErrResponseFileDepth, limit)
+}
+
+// redactedSentinels are the errors kept in the chain of the redacted error, as their messages
+// never contain the input.
+var redactedSentinels = []error{
+	ErrUnknownFlag, ErrUnknownShorthand, ErrFlagVisited, ErrNoValueProvided, ErrParseFailed,
+	ErrTypeMismatch, ErrNoParserDefined, ErrCmdParserIsNotImplemented, ErrEnvParserIsNotImplemented,
+	ErrInvalidKeyValue, ErrDuplicateKey, ErrInvalidChoice, ErrInvalidValue, ErrAmbiguousFlag,
+	ErrResponseFile, ErrResponseFileDepth, ErrUnterminatedQuote, ErrValueFile, ErrValueFileRepeated,
+	strconv.ErrSyntax, strconv.ErrRange,
+}
+
+// redactedError replaces the message of the original error, which may contain the secret input
+// or any part of it. Only the sentinel errors matched by the original error are kept in the chain,
+// so that they are available to errors.Is, while the original error is dropped.
+type redactedError struct {
+	flagName  string
+	sentinels []error
+}
+
+func (e *redactedError) Error() string {
+	return fmt.Sprintf("%s: %s: %s", e.flagName, parseErrorMessage, redactedValue)
+}
+
+func (e *redactedError) Unwrap() []error {
+	return e.sentinels
+}
+
+// Redact returns the error of parsing the secret flag with the message naming the flag only,
+// e.g. "token: failed to parse flag: [REDACTED]". It returns nil if the error is nil.
+func Redact(flagName string, err error) error {
+	if err == nil {
+		return nil
+	}
+	var sentinels []error
+	for _, sentinel := range redactedSentinels {
+		if errors.Is(err, sentinel) {
+			sentinels = append(sentinels, sentinel)
+		}
+	}
+	return &redactedError{flagName: flagName, sentinels: sentinels}
+}
+
+func ValueFile(path string, err error) error {
+	return errors.Join(fmt.Errorf("%s: %w", path, ErrValueFile), err)
+}
+
+func ValueFileRepeated(flagName string) error {
+	return fmt.Errorf("%s: %w", flagName, ErrValueFileRepeated)
+}
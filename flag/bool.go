@@ -40,6 +40,11 @@ func (p *boolParser) ParseCmd(input string) (any, error) {
 	return &parsed, nil
 }
 
+// IsBoolFlag reports that the flag takes no separate command-line argument.
+func (p *boolParser) IsBoolFlag() bool {
+	return true
+}
+
 func (p *boolParser) ParseEnv(input string) (any, error) {
 	var (
 		parsed bool
@@ -51,8 +56,11 @@ func (p *boolParser) ParseEnv(input string) (any, error) {
 	return &parsed, nil
 }
 
+// Bool creates a boolean flag, which may be set to false with the `--no-<name>` form,
+// unless the NoNegation option is given.
 func Bool(name string, opts ...Option) *BoolFlag {
 	f := newFlag[bool](name)
+	f.negatable = true
 	applyForFlag(f, opts...)
 	if f.Parser() == nil {
 		f.setParser(defaultBoolParser())
@@ -2,9 +2,8 @@ package flag
 
 import (
 	"strconv"
-	"strings"
 
-	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/parser"
 )
 
 type boolSlice = flag[[]bool]
@@ -13,46 +12,8 @@ type BoolSliceFlag struct {
 	*boolSlice
 }
 
-type boolSliceParser struct {
-	*embeddedParser
-}
-
-func defaultBoolSliceParser() *boolSliceParser {
-	return &boolSliceParser{&embeddedParser{}}
-}
-
-func (p *boolSliceParser) ParseCmd(input string) (any, error) {
-	var empty string
-	if input == empty {
-		return nil, errors.ErrNoValueProvided
-	}
-	s := strings.Split(input, p.Separator())
-	parsed := make([]bool, 0, len(s))
-	for _, el := range s {
-		v, err := strconv.ParseBool(el)
-		if err != nil {
-			return nil, err
-		}
-		parsed = append(parsed, v)
-	}
-	if p.IsSetFromCmd() {
-		stored := DerefOrDie[[]bool](p.CurrentValue())
-		parsed = append(stored, parsed...)
-	}
-	return &parsed, nil
-}
-
-func (p *boolSliceParser) ParseEnv(value string) (any, error) {
-	s := strings.Split(value, p.Separator())
-	parsed := make([]bool, 0, len(s))
-	for _, el := range s {
-		v, err := strconv.ParseBool(el)
-		if err != nil {
-			return nil, err
-		}
-		parsed = append(parsed, v)
-	}
-	return &parsed, nil
+func defaultBoolSliceParser() *parser.SliceParser[bool] {
+	return parser.Slice(parser.Func(strconv.ParseBool))
 }
 
 func BoolSlice(name string, opts ...Option) *BoolSliceFlag {
@@ -0,0 +1,112 @@
+package flag
+
+import (
+	"strings"
+
+	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/parser"
+)
+
+// ByteSizeValue is the size in bytes held by ByteSize flags. It is parsed from a number with an optional
+// SI (`kB`, `MB`, `GB`, ...) or IEC (`KiB`, `MiB`, `GiB`, ...) suffix, where
+// single-letter suffixes (`k`, `M`, `G`, ...) are treated as SI ones.
+type ByteSizeValue uint64
+
+const (
+	Byte ByteSizeValue = 1
+
+	KB ByteSizeValue = 1000 * Byte
+	MB ByteSizeValue = 1000 * KB
+	GB ByteSizeValue = 1000 * MB
+	TB ByteSizeValue = 1000 * GB
+	PB ByteSizeValue = 1000 * TB
+	EB ByteSizeValue = 1000 * PB
+
+	KiB ByteSizeValue = 1024 * Byte
+	MiB ByteSizeValue = 1024 * KiB
+	GiB ByteSizeValue = 1024 * MiB
+	TiB ByteSizeValue = 1024 * GiB
+	PiB ByteSizeValue = 1024 * TiB
+	EiB ByteSizeValue = 1024 * PiB
+)
+
+var (
+	byteSizeSIUnits = []unit{
+		{"EB", uint64(EB)}, {"PB", uint64(PB)}, {"TB", uint64(TB)},
+		{"GB", uint64(GB)}, {"MB", uint64(MB)}, {"kB", uint64(KB)},
+	}
+	byteSizeIECUnits = []unit{
+		{"EiB", uint64(EiB)}, {"PiB", uint64(PiB)}, {"TiB", uint64(TiB)},
+		{"GiB", uint64(GiB)}, {"MiB", uint64(MiB)}, {"KiB", uint64(KiB)},
+	}
+	byteSizeSuffixes = map[string]ByteSizeValue{
+		"": Byte, "b": Byte,
+		"k": KB, "kb": KB, "ki": KiB, "kib": KiB,
+		"m": MB, "mb": MB, "mi": MiB, "mib": MiB,
+		"g": GB, "gb": GB, "gi": GiB, "gib": GiB,
+		"t": TB, "tb": TB, "ti": TiB, "tib": TiB,
+		"p": PB, "pb": PB, "pi": PiB, "pib": PiB,
+		"e": EB, "eb": EB, "ei": EiB, "eib": EiB,
+	}
+)
+
+// ParseByteSize parses a human-readable size, such as `512MiB`, `1.5G` or `64k`.
+// The fractional part of the resulting number of bytes is truncated.
+func ParseByteSize(input string) (ByteSizeValue, error) {
+	number, suffix := splitUnit(strings.TrimSpace(input))
+	multiplier, ok := byteSizeSuffixes[strings.ToLower(suffix)]
+	if !ok {
+		return 0, errors.InvalidValue(input, "unknown size suffix "+suffix)
+	}
+	v, err := scaleNumber(input, number, uint64(multiplier))
+	if err != nil {
+		return 0, err
+	}
+	if !v.IsUint64() {
+		return 0, errors.InvalidValue(input, "size overflows 64-bit integer")
+	}
+	return ByteSizeValue(v.Uint64()), nil
+}
+
+// String renders the size in a human-readable form. IEC units are used if the size
+// is a whole multiple of one of them, otherwise the size is rendered in SI units.
+func (b ByteSizeValue) String() string {
+	s := formatUnit(uint64(b), byteSizeIECUnits, byteSizeSIUnits)
+	if b < KB {
+		s += "B"
+	}
+	return s
+}
+
+func (b ByteSizeValue) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+func (b *ByteSizeValue) UnmarshalText(text []byte) error {
+	v, err := ParseByteSize(string(text))
+	if err != nil {
+		return err
+	}
+	*b = v
+	return nil
+}
+
+type byteSize = flag[ByteSizeValue]
+
+type ByteSizeFlag struct {
+	*byteSize
+}
+
+func defaultByteSizeParser() *parser.FuncParser[ByteSizeValue] {
+	return parser.Func(ParseByteSize)
+}
+
+// ByteSize creates a flag holding a ByteSizeValue.
+func ByteSize(name string, opts ...Option) *ByteSizeFlag {
+	f := newFlag[ByteSizeValue](name)
+	applyForFlag(f, opts...)
+	if f.Parser() == nil {
+		f.setParser(defaultByteSizeParser())
+	}
+	return &ByteSizeFlag{f}
+}
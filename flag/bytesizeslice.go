@@ -0,0 +1,23 @@
+package flag
+
+import "github.com/brongineer/helium/parser"
+
+type byteSizeSlice = flag[[]ByteSizeValue]
+
+type ByteSizeSliceFlag struct {
+	*byteSizeSlice
+}
+
+func defaultByteSizeSliceParser() *parser.SliceParser[ByteSizeValue] {
+	return parser.Slice(parser.Func(ParseByteSize))
+}
+
+// ByteSizeSlice creates a flag holding a list of ByteSizeValue values.
+func ByteSizeSlice(name string, opts ...Option) *ByteSizeSliceFlag {
+	f := newFlag[[]ByteSizeValue](name)
+	applyForFlag(f, opts...)
+	if f.Parser() == nil {
+		f.setParser(defaultByteSizeSliceParser())
+	}
+	return &ByteSizeSliceFlag{f}
+}
@@ -36,6 +36,11 @@ func (p *counterParser) ParseCmd(input string) (any, error) {
 	return &parsed, nil
 }
 
+// IsBoolFlag reports that the flag takes no separate command-line argument.
+func (p *counterParser) IsBoolFlag() bool {
+	return true
+}
+
 func (p *counterParser) ParseEnv(input string) (any, error) {
 	var (
 		parsed int
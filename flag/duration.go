@@ -35,6 +35,11 @@ func (p *durationParser) ParseCmd(input string) (any, error) {
 	return &parsed, nil
 }
 
+// AcceptsLeadingDash reports that the flag accepts negative values.
+func (p *durationParser) AcceptsLeadingDash() bool {
+	return true
+}
+
 func (p *durationParser) ParseEnv(input string) (any, error) {
 	var (
 		parsed time.Duration
@@ -1,10 +1,9 @@
 package flag
 
 import (
-	"strings"
 	"time"
 
-	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/parser"
 )
 
 type durationSlice = flag[[]time.Duration]
@@ -13,46 +12,8 @@ type DurationSliceFlag struct {
 	*durationSlice
 }
 
-type durationSliceParser struct {
-	*embeddedParser
-}
-
-func defaultDurationSliceParser() *durationSliceParser {
-	return &durationSliceParser{&embeddedParser{}}
-}
-
-func (p *durationSliceParser) ParseCmd(input string) (any, error) {
-	var empty string
-	if input == empty {
-		return nil, errors.ErrNoValueProvided
-	}
-	s := strings.Split(input, p.Separator())
-	parsed := make([]time.Duration, 0, len(s))
-	for _, el := range s {
-		v, err := time.ParseDuration(el)
-		if err != nil {
-			return nil, err
-		}
-		parsed = append(parsed, v)
-	}
-	if p.IsSetFromCmd() {
-		stored := DerefOrDie[[]time.Duration](p.CurrentValue())
-		parsed = append(stored, parsed...)
-	}
-	return &parsed, nil
-}
-
-func (p *durationSliceParser) ParseEnv(input string) (any, error) {
-	s := strings.Split(input, p.Separator())
-	parsed := make([]time.Duration, 0, len(s))
-	for _, el := range s {
-		v, err := time.ParseDuration(el)
-		if err != nil {
-			return nil, err
-		}
-		parsed = append(parsed, v)
-	}
-	return &parsed, nil
+func defaultDurationSliceParser() *parser.SliceParser[time.Duration] {
+	return parser.Slice(parser.Signed(parser.Func(time.ParseDuration)))
 }
 
 func DurationSlice(name string, opts ...Option) *DurationSliceFlag {
@@ -0,0 +1,131 @@
+package flag
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/brongineer/helium/errors"
+)
+
+type EnumFlag[T ~string] struct {
+	*flag[T]
+	choices []string
+}
+
+type enumParser[T ~string] struct {
+	*embeddedParser
+	choices    []string
+	aliases    map[string]string
+	ignoreCase bool
+}
+
+func defaultEnumParser[T ~string](choices []string, aliases map[string]string, ignoreCase bool) *enumParser[T] {
+	return &enumParser[T]{
+		embeddedParser: &embeddedParser{},
+		choices:        choices,
+		aliases:        aliases,
+		ignoreCase:     ignoreCase,
+	}
+}
+
+func (p *enumParser[T]) equal(a, b string) bool {
+	if p.ignoreCase {
+		return strings.EqualFold(a, b)
+	}
+	return a == b
+}
+
+func (p *enumParser[T]) lookup(input string) (*T, error) {
+	for _, choice := range p.choices {
+		if p.equal(choice, input) {
+			parsed := T(choice)
+			return &parsed, nil
+		}
+	}
+	for alias, choice := range p.aliases {
+		if p.equal(alias, input) {
+			parsed := T(choice)
+			return &parsed, nil
+		}
+	}
+	return nil, errors.InvalidChoice(input, p.choices)
+}
+
+func (p *enumParser[T]) ParseCmd(input string) (any, error) {
+	if p.IsSetFromCmd() {
+		return nil, errors.ErrFlagVisited
+	}
+	var empty string
+	if input == empty {
+		return nil, errors.ErrNoValueProvided
+	}
+	return p.lookup(input)
+}
+
+func (p *enumParser[T]) ParseEnv(input string) (any, error) {
+	return p.lookup(input)
+}
+
+// Choices returns the list of values accepted by the flag.
+func (f *EnumFlag[T]) Choices() []string {
+	return slices.Clone(f.choices)
+}
+
+// enumSetter accepts the options specific to enum flags along with the common ones.
+type enumSetter[T ~string] struct {
+	*flag[T]
+	aliases    map[string]string
+	ignoreCase bool
+}
+
+func (s *enumSetter[T]) setIgnoreCase() {
+	s.ignoreCase = true
+}
+
+func (s *enumSetter[T]) setChoiceAlias(alias, choice string) {
+	if s.aliases == nil {
+		s.aliases = make(map[string]string)
+	}
+	s.aliases[alias] = choice
+}
+
+func validateChoices[T ~string](f *flag[T], choices []string, aliases map[string]string) {
+	for alias, choice := range aliases {
+		if !slices.Contains(choices, choice) {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: alias %q refers to unknown choice %q\n", alias, choice)
+			os.Exit(1)
+		}
+	}
+	if f.defaultValue == nil {
+		return
+	}
+	if def := string(*f.defaultValue); !slices.Contains(choices, def) {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: default value %q is not one of the choices\n", def)
+		os.Exit(1)
+	}
+}
+
+// Enum creates a flag accepting only one of the given choices.
+// Additional spellings can be accepted with the ChoiceAlias option,
+// and the IgnoreCase option makes matching case-insensitive.
+func Enum[T ~string](name string, choices []T, opts ...Option) *EnumFlag[T] {
+	f := newFlag[T](name)
+	names := make([]string, 0, len(choices))
+	for _, choice := range choices {
+		names = append(names, string(choice))
+	}
+	s := &enumSetter[T]{flag: f}
+	applyForFlag(s, opts...)
+	validateChoices(f, names, s.aliases)
+	if f.Parser() == nil {
+		f.setParser(defaultEnumParser[T](names, s.aliases, s.ignoreCase))
+	}
+	return &EnumFlag[T]{f, names}
+}
+
+// Choice creates a string flag accepting only one of the given choices.
+func Choice(name string, choices []string, opts ...Option) *EnumFlag[string] {
+	return Enum[string](name, choices, opts...)
+}
@@ -0,0 +1,53 @@
+package flag
+
+import (
+	"io"
+	"os"
+	"strings"
+
+	"github.com/brongineer/helium/errors"
+)
+
+const (
+	valueFilePrefix = "@"
+	stdinValue      = "-"
+)
+
+// stdin is the reader the `-` values are read from.
+var stdin io.Reader = os.Stdin
+
+// isValueFileRef reports whether the input refers to the file or stdin rather than being the value.
+func isValueFileRef(input string) bool {
+	if input == stdinValue {
+		return true
+	}
+	return strings.HasPrefix(input, valueFilePrefix) && len(input) > len(valueFilePrefix) &&
+		!strings.HasPrefix(input, valueFilePrefix+valueFilePrefix)
+}
+
+// readValueFile returns the content of the file, if the input is `@path`, or of stdin,
+// if the input is `-`, along with the path, or `-` for stdin. A single trailing newline
+// is removed from the content. Other inputs are returned as they are with an empty path,
+// except for the ones starting with `@@`, which are returned with the first at-sign removed.
+func readValueFile(input string) (string, string, error) {
+	var (
+		content []byte
+		err     error
+	)
+	switch {
+	case strings.HasPrefix(input, valueFilePrefix+valueFilePrefix):
+		return strings.TrimPrefix(input, valueFilePrefix), "", nil
+	case input == stdinValue:
+		content, err = io.ReadAll(stdin)
+	case strings.HasPrefix(input, valueFilePrefix) && len(input) > len(valueFilePrefix):
+		input = strings.TrimPrefix(input, valueFilePrefix)
+		content, err = os.ReadFile(input)
+	default:
+		return input, "", nil
+	}
+	if err != nil {
+		return "", "", errors.ValueFile(input, err)
+	}
+	value := strings.TrimSuffix(string(content), "\n")
+	return strings.TrimSuffix(value, "\r"), input, nil
+}
@@ -1,11 +1,20 @@
 package flag
 
 import (
+	"log/slog"
+	"net/netip"
+	"net/url"
+	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/parser"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type flagPropertyGetter interface {
@@ -2795,3 +2804,1303 @@ func TestFlag_GetCounter(t *testing.T) {
 		})
 	}
 }
+
+func TestFlag_StringMap(t *testing.T) {
+	t.Parallel()
+	tests := []flagTest{
+		{
+			"sample",
+			[]Option{Separator(";")},
+			expected{
+				separator: ";",
+			},
+		},
+		{
+			"sample",
+			[]Option{DefaultValue(map[string]string{"env": "prod"})},
+			expected{defaultValue: map[string]string{"env": "prod"}},
+		},
+		{
+			"sample",
+			[]Option{
+				Description("description"),
+				Shorthand("l"),
+			},
+			expected{
+				description: "description",
+				shorthand:   "l",
+			},
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := StringMap(tt.name, tt.opts...)
+			assertFlag[map[string]string](t, f, tt)
+		})
+	}
+}
+
+func TestFlag_GetStringMap(t *testing.T) {
+	t.Parallel()
+	tests := []getFlagTest[map[string]string]{
+		{
+			"sample",
+			[]Option{},
+			ptrTo("env=prod,tier=web"),
+			result[map[string]string]{
+				ptrTo(map[string]string{"env": "prod", "tier": "web"}),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{Separator(";")},
+			ptrTo("selector=a=b;empty="),
+			result[map[string]string]{
+				ptrTo(map[string]string{"selector": "a=b", "empty": ""}),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("env=prod,env=dev"),
+			result[map[string]string]{
+				ptrTo(map[string]string{"env": "dev"}),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{DuplicateKeys(DuplicateKeyError)},
+			ptrTo("env=prod,env=dev"),
+			result[map[string]string]{
+				nil,
+				true,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("env"),
+			result[map[string]string]{
+				nil,
+				true,
+			},
+		},
+		{
+			"sample",
+			[]Option{DefaultValue(map[string]string{"env": "prod"})},
+			nil,
+			result[map[string]string]{
+				ptrTo(map[string]string{"env": "prod"}),
+				false,
+			},
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := StringMap(tt.name, tt.opts...)
+			assertGetFlagCmd[map[string]string](t, f, tt)
+			assertGetFlagEnv[map[string]string](t, f, tt)
+		})
+	}
+}
+
+func TestFlag_GetIntMap(t *testing.T) {
+	t.Parallel()
+	tests := []getFlagTest[map[string]int]{
+		{
+			"sample",
+			[]Option{},
+			ptrTo("cpu=2,memory=512"),
+			result[map[string]int]{
+				ptrTo(map[string]int{"cpu": 2, "memory": 512}),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("cpu=two"),
+			result[map[string]int]{
+				nil,
+				true,
+			},
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := IntMap(tt.name, tt.opts...)
+			assertGetFlagCmd[map[string]int](t, f, tt)
+			assertGetFlagEnv[map[string]int](t, f, tt)
+		})
+	}
+}
+
+func TestFlag_GetMap(t *testing.T) {
+	t.Parallel()
+	tests := []getFlagTest[map[int]time.Duration]{
+		{
+			"sample",
+			[]Option{},
+			ptrTo("1=1s,2=2m"),
+			result[map[int]time.Duration]{
+				ptrTo(map[int]time.Duration{1: time.Second, 2: 2 * time.Minute}),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("one=1s"),
+			result[map[int]time.Duration]{
+				nil,
+				true,
+			},
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := Map[int, time.Duration](tt.name, tt.opts...)
+			assertGetFlagCmd[map[int]time.Duration](t, f, tt)
+			assertGetFlagEnv[map[int]time.Duration](t, f, tt)
+		})
+	}
+}
+
+func TestFlag_MapRepeated(t *testing.T) {
+	t.Parallel()
+	f := StringMap("sample")
+	assert.NoError(t, f.FromCommandLine("env=prod"))
+	assert.NoError(t, f.FromCommandLine("tier=web,env=dev"))
+	assert.Equal(t, map[string]string{"env": "dev", "tier": "web"}, DerefOrDie[map[string]string](f.Value()))
+
+	strict := StringMap("sample", DuplicateKeys(DuplicateKeyError))
+	assert.NoError(t, strict.FromCommandLine("env=prod"))
+	assert.Error(t, strict.FromCommandLine("env=dev"))
+
+	unsupported := Map[custom, string]("sample")
+	assert.ErrorIs(t, unsupported.FromCommandLine("a=b"), errors.ErrNoParserDefined)
+}
+
+type logLevel string
+
+func TestFlag_Enum(t *testing.T) {
+	t.Parallel()
+	tests := []flagTest{
+		{
+			"sample",
+			[]Option{},
+			expected{},
+		},
+		{
+			"sample",
+			[]Option{DefaultValue(logLevel("info"))},
+			expected{defaultValue: logLevel("info")},
+		},
+		{
+			"sample",
+			[]Option{
+				Description("description"),
+				Shorthand("l"),
+			},
+			expected{
+				description: "description",
+				shorthand:   "l",
+			},
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := Enum(tt.name, []logLevel{"debug", "info", "warning"}, tt.opts...)
+			assertFlag[logLevel](t, f, tt)
+			assert.Equal(t, []string{"debug", "info", "warning"}, f.Choices())
+		})
+	}
+}
+
+func TestFlag_GetEnum(t *testing.T) {
+	t.Parallel()
+	tests := []getFlagTest[logLevel]{
+		{
+			"sample",
+			[]Option{},
+			ptrTo("debug"),
+			result[logLevel]{
+				ptrTo(logLevel("debug")),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("DEBUG"),
+			result[logLevel]{
+				nil,
+				true,
+			},
+		},
+		{
+			"sample",
+			[]Option{IgnoreCase()},
+			ptrTo("DEBUG"),
+			result[logLevel]{
+				ptrTo(logLevel("debug")),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{ChoiceAlias("warn", "warning")},
+			ptrTo("warn"),
+			result[logLevel]{
+				ptrTo(logLevel("warning")),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{ChoiceAlias("warn", "warning"), IgnoreCase()},
+			ptrTo("Warn"),
+			result[logLevel]{
+				ptrTo(logLevel("warning")),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{DefaultValue(logLevel("info"))},
+			nil,
+			result[logLevel]{
+				ptrTo(logLevel("info")),
+				false,
+			},
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := Enum(tt.name, []logLevel{"debug", "info", "warning"}, tt.opts...)
+			assertGetFlagCmd[logLevel](t, f, tt)
+			assertGetFlagEnv[logLevel](t, f, tt)
+		})
+	}
+}
+
+func TestFlag_ChoiceError(t *testing.T) {
+	t.Parallel()
+	f := Choice("format", []string{"json", "text"})
+	err := f.FromCommandLine("yaml")
+	assert.ErrorIs(t, err, errors.ErrInvalidChoice)
+	assert.ErrorContains(t, err, `"yaml": invalid choice, valid choices are: json, text`)
+}
+
+func TestFlag_GetIP(t *testing.T) {
+	t.Parallel()
+	tests := []getFlagTest[netip.Addr]{
+		{
+			"sample",
+			[]Option{},
+			ptrTo("10.0.0.1"),
+			result[netip.Addr]{
+				ptrTo(netip.MustParseAddr("10.0.0.1")),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{IPv6Only()},
+			ptrTo("2001:db8::1"),
+			result[netip.Addr]{
+				ptrTo(netip.MustParseAddr("2001:db8::1")),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{IPv4Only()},
+			ptrTo("2001:db8::1"),
+			result[netip.Addr]{
+				nil,
+				true,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("10.0.0.256"),
+			result[netip.Addr]{
+				nil,
+				true,
+			},
+		},
+		{
+			"sample",
+			[]Option{DefaultValue(netip.IPv6Loopback())},
+			nil,
+			result[netip.Addr]{
+				ptrTo(netip.IPv6Loopback()),
+				false,
+			},
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := IP(tt.name, tt.opts...)
+			assertGetFlagCmd[netip.Addr](t, f, tt)
+			assertGetFlagEnv[netip.Addr](t, f, tt)
+		})
+	}
+}
+
+func TestFlag_GetIPSlice(t *testing.T) {
+	t.Parallel()
+	tests := []getFlagTest[[]netip.Addr]{
+		{
+			"sample",
+			[]Option{},
+			ptrTo("10.0.0.1,10.0.0.2"),
+			result[[]netip.Addr]{
+				ptrTo([]netip.Addr{netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("10.0.0.2")}),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{IPv4Only()},
+			ptrTo("10.0.0.1,::1"),
+			result[[]netip.Addr]{
+				nil,
+				true,
+			},
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := IPSlice(tt.name, tt.opts...)
+			assertGetFlagCmd[[]netip.Addr](t, f, tt)
+			assertGetFlagEnv[[]netip.Addr](t, f, tt)
+		})
+	}
+}
+
+func TestFlag_GetPrefix(t *testing.T) {
+	t.Parallel()
+	tests := []getFlagTest[netip.Prefix]{
+		{
+			"sample",
+			[]Option{},
+			ptrTo("10.0.0.0/8"),
+			result[netip.Prefix]{
+				ptrTo(netip.MustParsePrefix("10.0.0.0/8")),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{IPv4Only()},
+			ptrTo("fd00::/8"),
+			result[netip.Prefix]{
+				nil,
+				true,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("10.0.0.0"),
+			result[netip.Prefix]{
+				nil,
+				true,
+			},
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := Prefix(tt.name, tt.opts...)
+			assertGetFlagCmd[netip.Prefix](t, f, tt)
+			assertGetFlagEnv[netip.Prefix](t, f, tt)
+		})
+	}
+}
+
+func TestFlag_GetPrefixSlice(t *testing.T) {
+	t.Parallel()
+	tests := []getFlagTest[[]netip.Prefix]{
+		{
+			"sample",
+			[]Option{},
+			ptrTo("10.0.0.0/8,fd00::/8"),
+			result[[]netip.Prefix]{
+				ptrTo([]netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("fd00::/8")}),
+				false,
+			},
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := PrefixSlice(tt.name, tt.opts...)
+			assertGetFlagCmd[[]netip.Prefix](t, f, tt)
+			assertGetFlagEnv[[]netip.Prefix](t, f, tt)
+		})
+	}
+}
+
+func TestFlag_GetHostPort(t *testing.T) {
+	t.Parallel()
+	tests := []getFlagTest[Endpoint]{
+		{
+			"sample",
+			[]Option{},
+			ptrTo("example.com:443"),
+			result[Endpoint]{
+				ptrTo(Endpoint{Host: "example.com", Port: 443}),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo(":8080"),
+			result[Endpoint]{
+				ptrTo(Endpoint{Port: 8080}),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{IPv6Only()},
+			ptrTo("[::1]:8080"),
+			result[Endpoint]{
+				ptrTo(Endpoint{Host: "::1", Port: 8080}),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{IPv6Only()},
+			ptrTo("127.0.0.1:8080"),
+			result[Endpoint]{
+				nil,
+				true,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("example.com"),
+			result[Endpoint]{
+				nil,
+				true,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("example.com:70000"),
+			result[Endpoint]{
+				nil,
+				true,
+			},
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := HostPort(tt.name, tt.opts...)
+			assertGetFlagCmd[Endpoint](t, f, tt)
+			assertGetFlagEnv[Endpoint](t, f, tt)
+		})
+	}
+}
+
+func TestFlag_GetHostPortSlice(t *testing.T) {
+	t.Parallel()
+	tests := []getFlagTest[[]Endpoint]{
+		{
+			"sample",
+			[]Option{},
+			ptrTo("a:1,b:2"),
+			result[[]Endpoint]{
+				ptrTo([]Endpoint{{Host: "a", Port: 1}, {Host: "b", Port: 2}}),
+				false,
+			},
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := HostPortSlice(tt.name, tt.opts...)
+			assertGetFlagCmd[[]Endpoint](t, f, tt)
+			assertGetFlagEnv[[]Endpoint](t, f, tt)
+		})
+	}
+}
+
+func TestFlag_GetURL(t *testing.T) {
+	t.Parallel()
+	tests := []getFlagTest[url.URL]{
+		{
+			"sample",
+			[]Option{},
+			ptrTo("http://example.com/path"),
+			result[url.URL]{
+				ptrTo(url.URL{Scheme: "http", Host: "example.com", Path: "/path"}),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{URLSchemes("https")},
+			ptrTo("HTTPS://example.com"),
+			result[url.URL]{
+				ptrTo(url.URL{Scheme: "https", Host: "example.com"}),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{URLSchemes("https")},
+			ptrTo("http://example.com"),
+			result[url.URL]{
+				nil,
+				true,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("example.com/path"),
+			result[url.URL]{
+				nil,
+				true,
+			},
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := URL(tt.name, tt.opts...)
+			assertGetFlagCmd[url.URL](t, f, tt)
+			assertGetFlagEnv[url.URL](t, f, tt)
+		})
+	}
+}
+
+func TestFlag_GetURLSlice(t *testing.T) {
+	t.Parallel()
+	tests := []getFlagTest[[]url.URL]{
+		{
+			"sample",
+			[]Option{URLSchemes("http", "https")},
+			ptrTo("http://a,https://b"),
+			result[[]url.URL]{
+				ptrTo([]url.URL{{Scheme: "http", Host: "a"}, {Scheme: "https", Host: "b"}}),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{URLSchemes("https")},
+			ptrTo("https://a,ftp://b"),
+			result[[]url.URL]{
+				nil,
+				true,
+			},
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := URLSlice(tt.name, tt.opts...)
+			assertGetFlagCmd[[]url.URL](t, f, tt)
+			assertGetFlagEnv[[]url.URL](t, f, tt)
+		})
+	}
+}
+
+func TestFlag_NetworkValidationError(t *testing.T) {
+	t.Parallel()
+	err := IP("sample", IPv4Only()).FromCommandLine("::1")
+	assert.ErrorIs(t, err, errors.ErrParseFailed)
+	assert.ErrorIs(t, err, errors.ErrInvalidValue)
+	assert.ErrorContains(t, err, "IPv4 address required")
+}
+
+func TestFlag_GetByteSize(t *testing.T) {
+	t.Parallel()
+	tests := []getFlagTest[ByteSizeValue]{
+		{
+			"sample",
+			[]Option{},
+			ptrTo("512MiB"),
+			result[ByteSizeValue]{
+				ptrTo(512 * MiB),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("1.5G"),
+			result[ByteSizeValue]{
+				ptrTo(1500 * MB),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("64k"),
+			result[ByteSizeValue]{
+				ptrTo(64 * KB),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("4096"),
+			result[ByteSizeValue]{
+				ptrTo(4 * KiB),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("16EiB"),
+			result[ByteSizeValue]{
+				nil,
+				true,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("-1k"),
+			result[ByteSizeValue]{
+				nil,
+				true,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("10 parsecs"),
+			result[ByteSizeValue]{
+				nil,
+				true,
+			},
+		},
+		{
+			"sample",
+			[]Option{DefaultValue(GiB)},
+			nil,
+			result[ByteSizeValue]{
+				ptrTo(GiB),
+				false,
+			},
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := ByteSize(tt.name, tt.opts...)
+			assertGetFlagCmd[ByteSizeValue](t, f, tt)
+			assertGetFlagEnv[ByteSizeValue](t, f, tt)
+		})
+	}
+}
+
+func TestFlag_GetSizeSlice(t *testing.T) {
+	t.Parallel()
+	tests := []getFlagTest[[]ByteSizeValue]{
+		{
+			"sample",
+			[]Option{},
+			ptrTo("1KiB,2kB"),
+			result[[]ByteSizeValue]{
+				ptrTo([]ByteSizeValue{KiB, 2 * KB}),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("1KiB,2XB"),
+			result[[]ByteSizeValue]{
+				nil,
+				true,
+			},
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := ByteSizeSlice(tt.name, tt.opts...)
+			assertGetFlagCmd[[]ByteSizeValue](t, f, tt)
+			assertGetFlagEnv[[]ByteSizeValue](t, f, tt)
+		})
+	}
+}
+
+func TestByteSize_String(t *testing.T) {
+	t.Parallel()
+	tests := map[ByteSizeValue]string{
+		0:          "0B",
+		512:        "512B",
+		512 * MiB:  "512MiB",
+		1500 * MB:  "1.5GB",
+		64 * KB:    "64kB",
+		EiB:        "1EiB",
+		1126:       "1.126kB",
+		4 * KiB:    "4KiB",
+		10*TB + GB: "10.001TB",
+	}
+	for size, expected := range tests {
+		assert.Equal(t, expected, size.String())
+		parsed, err := ParseByteSize(expected)
+		assert.NoError(t, err)
+		assert.Equal(t, size, parsed)
+	}
+}
+
+func TestFlag_GetQuantity(t *testing.T) {
+	t.Parallel()
+	tests := []getFlagTest[QuantityValue]{
+		{
+			"sample",
+			[]Option{},
+			ptrTo("64k"),
+			result[QuantityValue]{
+				ptrTo(QuantityValue(64000)),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("-1.5M"),
+			result[QuantityValue]{
+				ptrTo(QuantityValue(-1500000)),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("10E"),
+			result[QuantityValue]{
+				nil,
+				true,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("1m"),
+			result[QuantityValue]{
+				nil,
+				true,
+			},
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := Quantity(tt.name, tt.opts...)
+			assertGetFlagCmd[QuantityValue](t, f, tt)
+			assertGetFlagEnv[QuantityValue](t, f, tt)
+		})
+	}
+}
+
+func TestFlag_GetQuantitySlice(t *testing.T) {
+	t.Parallel()
+	tests := []getFlagTest[[]QuantityValue]{
+		{
+			"sample",
+			[]Option{},
+			ptrTo("1k,2G"),
+			result[[]QuantityValue]{
+				ptrTo([]QuantityValue{1000, 2000000000}),
+				false,
+			},
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := QuantitySlice(tt.name, tt.opts...)
+			assertGetFlagCmd[[]QuantityValue](t, f, tt)
+			assertGetFlagEnv[[]QuantityValue](t, f, tt)
+		})
+	}
+}
+
+func TestSIQuantity_String(t *testing.T) {
+	t.Parallel()
+	tests := map[QuantityValue]string{
+		0:                   "0",
+		999:                 "999",
+		-1500000:            "-1.5M",
+		64000:               "64k",
+		3000000000000000000: "3E",
+	}
+	for quantity, expected := range tests {
+		assert.Equal(t, expected, quantity.String())
+		parsed, err := ParseQuantity(expected)
+		assert.NoError(t, err)
+		assert.Equal(t, quantity, parsed)
+	}
+}
+
+func TestFlag_GetTime(t *testing.T) {
+	t.Parallel()
+	cest := time.FixedZone("CEST", 2*60*60)
+	tests := []getFlagTest[time.Time]{
+		{
+			"sample",
+			[]Option{},
+			ptrTo("2024-05-01T10:00:00Z"),
+			result[time.Time]{
+				ptrTo(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("2024-05-01"),
+			result[time.Time]{
+				nil,
+				true,
+			},
+		},
+		{
+			"sample",
+			[]Option{TimeLayouts(time.RFC3339, time.DateOnly)},
+			ptrTo("2024-05-01"),
+			result[time.Time]{
+				ptrTo(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{TimeLayouts(time.DateTime), Location(cest)},
+			ptrTo("2024-05-01 10:00:00"),
+			result[time.Time]{
+				ptrTo(time.Date(2024, 5, 1, 10, 0, 0, 0, cest)),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("now-2x"),
+			result[time.Time]{
+				nil,
+				true,
+			},
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := Time(tt.name, tt.opts...)
+			assertGetFlagCmd[time.Time](t, f, tt)
+			assertGetFlagEnv[time.Time](t, f, tt)
+		})
+	}
+}
+
+func TestFlag_TimeRelative(t *testing.T) {
+	t.Parallel()
+	loc := time.FixedZone("UTC+3", 3*60*60)
+	now := time.Now().In(loc)
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{"now", now},
+		{"now-2h", now.Add(-2 * time.Hour)},
+		{"now+15m", now.Add(15 * time.Minute)},
+		{"today", midnight},
+		{"yesterday", midnight.AddDate(0, 0, -1)},
+		{"tomorrow+9h30m", midnight.AddDate(0, 0, 1).Add(9*time.Hour + 30*time.Minute)},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			f := Time("sample", Location(loc))
+			assert.NoError(t, f.FromCommandLine(tt.input))
+			actual := DerefOrDie[time.Time](f.Value())
+			assert.WithinDuration(t, tt.expected, actual, time.Minute)
+			assert.Equal(t, loc, actual.Location())
+		})
+	}
+}
+
+type listValue []string
+
+func (l *listValue) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listValue) Set(s string) error {
+	*l = append(*l, s)
+	return nil
+}
+
+type switchValue bool
+
+func (s *switchValue) String() string {
+	return strconv.FormatBool(bool(*s))
+}
+
+func (s *switchValue) Set(v string) error {
+	b, err := strconv.ParseBool(v)
+	*s = switchValue(b)
+	return err
+}
+
+func (s *switchValue) IsBoolFlag() bool {
+	return true
+}
+
+func TestFlag_GetTypedTextUnmarshaler(t *testing.T) {
+	t.Parallel()
+	tests := []getFlagTest[slog.Level]{
+		{
+			"sample",
+			[]Option{},
+			ptrTo("warn"),
+			result[slog.Level]{
+				ptrTo(slog.LevelWarn),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("INFO+2"),
+			result[slog.Level]{
+				ptrTo(slog.LevelInfo + 2),
+				false,
+			},
+		},
+		{
+			"sample",
+			[]Option{},
+			ptrTo("verbose"),
+			result[slog.Level]{
+				nil,
+				true,
+			},
+		},
+		{
+			"sample",
+			[]Option{DefaultValue(slog.LevelError)},
+			nil,
+			result[slog.Level]{
+				ptrTo(slog.LevelError),
+				false,
+			},
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := Typed[slog.Level](tt.name, tt.opts...)
+			assertGetFlagCmd[slog.Level](t, f, tt)
+			assertGetFlagEnv[slog.Level](t, f, tt)
+		})
+	}
+}
+
+func TestFlag_TypedStdValue(t *testing.T) {
+	t.Parallel()
+	list := Typed[listValue]("sample")
+	assert.NoError(t, list.FromCommandLine("a"))
+	assert.NoError(t, list.FromCommandLine("b"))
+	assert.Equal(t, listValue{"a", "b"}, DerefOrDie[listValue](list.Value()))
+	assert.ErrorIs(t, list.FromCommandLine(""), errors.ErrNoValueProvided)
+	assert.NoError(t, list.FromEnvVariable("c"))
+	assert.Equal(t, listValue{"c"}, DerefOrDie[listValue](list.Value()))
+
+	sw := Typed[switchValue]("sample")
+	assert.NoError(t, sw.FromCommandLine(""))
+	assert.Equal(t, switchValue(true), DerefOrDie[switchValue](sw.Value()))
+
+	noParser := Typed[custom]("sample")
+	assert.ErrorIs(t, noParser.FromCommandLine("1"), errors.ErrNoParserDefined)
+}
+
+func TestFlag_ContextParser(t *testing.T) {
+	t.Parallel()
+	var contexts []parser.Context
+	record := parser.ContextParserFunc(func(ctx parser.Context, s string) (any, error) {
+		contexts = append(contexts, ctx)
+		return strconv.Atoi(s)
+	})
+	f := Int("sample", ContextParser(record))
+	assert.ErrorIs(t, f.FromCommandLine("1"), errors.ErrTypeMismatch)
+	assert.False(t, f.IsSetFromCmd())
+
+	counter := 0
+	record = func(ctx parser.Context, s string) (any, error) {
+		contexts = append(contexts, ctx)
+		counter++
+		return &counter, nil
+	}
+	f = Int("sample", ContextParser(record))
+	assert.NoError(t, f.FromEnvVariable("1"))
+	assert.NoError(t, f.FromCommandLine("2"))
+	assert.Equal(t, 2, DerefOrDie[int](f.Value()))
+	require.Len(t, contexts, 3)
+	assert.Equal(t, parser.SourceEnvironment, contexts[1].Source)
+	assert.Equal(t, parser.SourceCommandLine, contexts[2].Source)
+	assert.Equal(t, "sample", contexts[2].FlagName)
+	assert.Equal(t, -1, contexts[2].ArgIndex)
+	assert.True(t, contexts[2].IsSetFromEnv)
+	assert.Nil(t, contexts[2].Lookup)
+}
+
+func TestFlag_AdaptedParser(t *testing.T) {
+	t.Parallel()
+	f := StringSlice("sample")
+	p := f.inputParser()
+	require.NotNil(t, p)
+	assert.Same(t, p, f.inputParser())
+	assert.NoError(t, f.FromCommandLine("a"))
+	assert.Same(t, p, f.inputParser())
+	assert.Nil(t, Typed[int]("sample").inputParser())
+}
+
+func TestFlag_StringFormat(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "", String("sample").String())
+	assert.Equal(t, "foo", String("sample", DefaultValue("foo")).String())
+
+	ints := IntSlice("sample", Separator(";"))
+	assert.NoError(t, ints.FromCommandLine("1;2"))
+	assert.Equal(t, "1;2", ints.String())
+
+	m := StringMap("sample")
+	assert.NoError(t, m.FromCommandLine("b=2,a=1"))
+	assert.Equal(t, "a=1,b=2", m.String())
+
+	ip := IP("sample")
+	assert.NoError(t, ip.FromCommandLine("10.0.0.1"))
+	assert.Equal(t, "10.0.0.1", ip.String())
+
+	size := ByteSize("sample", DefaultValue(2*MiB))
+	assert.Equal(t, "2MiB", size.String())
+
+	d := Duration("sample", DefaultValue(time.Minute))
+	assert.Equal(t, "1m0s", d.String())
+
+	ts := Time("sample")
+	assert.NoError(t, ts.FromCommandLine("2024-05-01T10:00:00Z"))
+	assert.Equal(t, "2024-05-01T10:00:00Z", ts.String())
+
+	urls := URLSlice("sample")
+	assert.NoError(t, urls.FromCommandLine("https://a.com/x?y=z,https://b.com"))
+	assert.Equal(t, "https://a.com/x?y=z,https://b.com", urls.String())
+}
+
+func TestFlag_TriBool(t *testing.T) {
+	t.Parallel()
+	f := TriBool("sample")
+	assert.Equal(t, TriUnset, DerefOrDie[TriState](f.Value()))
+	assert.True(t, f.IsNegatable())
+	assert.NoError(t, f.FromCommandLine(""))
+	assert.Equal(t, TriTrue, DerefOrDie[TriState](f.Value()))
+	assert.ErrorIs(t, f.FromCommandLine("false"), errors.ErrFlagVisited)
+
+	f = TriBool("sample", NoNegation())
+	assert.False(t, f.IsNegatable())
+	assert.NoError(t, f.FromEnvVariable("0"))
+	assert.Equal(t, TriFalse, DerefOrDie[TriState](f.Value()))
+	assert.Error(t, f.FromCommandLine("maybe"))
+
+	assert.True(t, Bool("sample").IsNegatable())
+	assert.False(t, String("sample").IsNegatable())
+}
+
+func TestTriState(t *testing.T) {
+	t.Parallel()
+	for _, s := range []TriState{TriUnset, TriTrue, TriFalse} {
+		text, err := s.MarshalText()
+		assert.NoError(t, err)
+		var parsed TriState
+		assert.NoError(t, parsed.UnmarshalText(text))
+		assert.Equal(t, s, parsed)
+	}
+	assert.True(t, TriUnset.Bool(true))
+	assert.False(t, TriFalse.Bool(true))
+	assert.True(t, TriTrue.IsSet())
+	assert.False(t, TriUnset.IsSet())
+	assert.Equal(t, TriTrue, TriStateOf(true))
+}
+
+func TestFlag_ValueFromFile(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(path, []byte("certificate\n"), 0o600))
+
+	f := String("ca-bundle", ValueFromFile())
+	assert.NoError(t, f.FromCommandLine("@"+path))
+	assert.Equal(t, "certificate", DerefOrDie[string](f.Value()))
+	assert.Equal(t, path, f.ValueFile())
+	assert.Equal(t, "@"+path, f.String())
+
+	f = String("ca-bundle", ValueFromFile())
+	assert.NoError(t, f.FromEnvVariable("@@literal"))
+	assert.Equal(t, "@literal", DerefOrDie[string](f.Value()))
+	assert.Empty(t, f.ValueFile())
+	assert.Equal(t, "@literal", f.String())
+
+	f = String("ca-bundle", ValueFromFile())
+	err := f.FromCommandLine("@" + filepath.Join(t.TempDir(), "missing.pem"))
+	assert.ErrorIs(t, err, errors.ErrParseFailed)
+	assert.ErrorIs(t, err, errors.ErrValueFile)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	f = String("ca-bundle")
+	assert.NoError(t, f.FromCommandLine("@"+path))
+	assert.Equal(t, "@"+path, DerefOrDie[string](f.Value()))
+	assert.Empty(t, f.ValueFile())
+}
+
+func TestFlag_ValueFromFileRepeated(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	a, b := filepath.Join(dir, "a.pem"), filepath.Join(dir, "b.pem")
+	require.NoError(t, os.WriteFile(a, []byte("a"), 0o600))
+	require.NoError(t, os.WriteFile(b, []byte("b"), 0o600))
+
+	f := StringSlice("certs", ValueFromFile())
+	assert.NoError(t, f.FromCommandLine("@"+a))
+	assert.ErrorIs(t, f.FromCommandLine("@"+b), errors.ErrValueFileRepeated)
+	assert.Equal(t, []string{"a"}, DerefOrDie[[]string](f.Value()))
+	assert.Equal(t, "@"+a, f.String())
+
+	f = StringSlice("certs", ValueFromFile())
+	assert.NoError(t, f.FromCommandLine("x"))
+	assert.ErrorIs(t, f.FromCommandLine("@"+b), errors.ErrValueFileRepeated)
+	assert.NoError(t, f.FromCommandLine("y"))
+	assert.Equal(t, []string{"x", "y"}, DerefOrDie[[]string](f.Value()))
+
+	f = StringSlice("certs", ValueFromFile(), Repeat(RepeatLastWins))
+	assert.NoError(t, f.FromCommandLine("@"+a))
+	assert.NoError(t, f.FromCommandLine("@"+b))
+	assert.Equal(t, []string{"b"}, DerefOrDie[[]string](f.Value()))
+	assert.Equal(t, []string{"--certs=@" + b}, f.Arguments())
+}
+
+func TestFlag_ValueFromStdin(t *testing.T) {
+	stdin = strings.NewReader("SELECT 1;\r\n")
+	t.Cleanup(func() { stdin = os.Stdin })
+	f := String("query", ValueFromFile())
+	assert.NoError(t, f.FromCommandLine("-"))
+	assert.Equal(t, "SELECT 1;", DerefOrDie[string](f.Value()))
+	assert.Equal(t, "-", f.ValueFile())
+	assert.Equal(t, "-", f.String())
+}
+
+func TestFlag_Secret(t *testing.T) {
+	t.Parallel()
+	f := String("token", Secret())
+	assert.True(t, f.IsSecret())
+	assert.Empty(t, f.String())
+	assert.NoError(t, f.FromCommandLine("s3cr3t"))
+	assert.Equal(t, "s3cr3t", DerefOrDie[string](f.Value()))
+	assert.Equal(t, "[REDACTED]", f.String())
+
+	n := Int("pin", Secret())
+	err := n.FromCommandLine("12a\"4")
+	assert.ErrorIs(t, err, errors.ErrParseFailed)
+	assert.NotContains(t, err.Error(), "12a")
+	assert.Contains(t, err.Error(), "[REDACTED]")
+
+	s := Enum("password", []string{"alpha", "beta"}, Secret())
+	err = s.FromCommandLine("gamma")
+	assert.ErrorIs(t, err, errors.ErrInvalidChoice)
+	assert.NotContains(t, err.Error(), "gamma")
+
+	pins := IntSlice("pins", Secret())
+	err = pins.FromCommandLine("1234,s3cr3t")
+	assert.ErrorIs(t, err, errors.ErrParseFailed)
+	assert.EqualError(t, err, "pins: failed to parse flag: [REDACTED]")
+
+	creds := StringMap("creds", Secret())
+	err = creds.FromCommandLine("user=bob,hunter2")
+	assert.ErrorIs(t, err, errors.ErrInvalidKeyValue)
+	assert.NotContains(t, err.Error(), "hunter2")
+	assert.NotContains(t, err.Error(), "bob")
+}
+
+func TestFlag_Arguments(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, String("name").Arguments())
+	f := String("name", DefaultValue("default"))
+	assert.Equal(t, []string{"--name=default"}, f.Arguments())
+	assert.NoError(t, f.FromEnvVariable("env"))
+	assert.Equal(t, []string{"--name=env"}, f.Arguments())
+
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(path, []byte("certificate"), 0o600))
+	f = String("ca-bundle", ValueFromFile(), Secret())
+	assert.NoError(t, f.FromCommandLine("@"+path))
+	assert.Equal(t, []string{"--ca-bundle=@" + path}, f.Arguments())
+
+	f = String("ca-bundle", ValueFromFile())
+	assert.NoError(t, f.FromCommandLine("@@literal"))
+	assert.Equal(t, []string{"--ca-bundle=@@literal"}, f.Arguments())
+
+	n := Int("mode", Formatter(func(v any) string { return strconv.FormatInt(int64(v.(int)), 8) }),
+		ContextParser(parser.ContextParserFunc(func(_ parser.Context, input string) (any, error) {
+			v, err := strconv.ParseInt(input, 8, 0)
+			i := int(v)
+			return &i, err
+		})))
+	assert.NoError(t, n.FromCommandLine("755"))
+	assert.Equal(t, 0o755, DerefOrDie[int](n.Value()))
+	assert.Equal(t, "755", n.String())
+	assert.Equal(t, []string{"--mode=755"}, n.Arguments())
+}
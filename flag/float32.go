@@ -36,6 +36,11 @@ func (p *float32Parser) ParseCmd(input string) (any, error) {
 	return &parsed, nil
 }
 
+// AcceptsLeadingDash reports that the flag accepts negative values.
+func (p *float32Parser) AcceptsLeadingDash() bool {
+	return true
+}
+
 func (p *float32Parser) ParseEnv(input string) (any, error) {
 	var (
 		v   float64
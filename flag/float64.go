@@ -35,6 +35,11 @@ func (p *float64Parser) ParseCmd(input string) (any, error) {
 	return &parsed, nil
 }
 
+// AcceptsLeadingDash reports that the flag accepts negative values.
+func (p *float64Parser) AcceptsLeadingDash() bool {
+	return true
+}
+
 func (p *float64Parser) ParseEnv(input string) (any, error) {
 	var (
 		parsed float64
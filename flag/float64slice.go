@@ -1,10 +1,7 @@
 package flag
 
 import (
-	"strconv"
-	"strings"
-
-	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/parser"
 )
 
 type float64Slice = flag[[]float64]
@@ -13,46 +10,8 @@ type Float64SliceFlag struct {
 	*float64Slice
 }
 
-type float64SliceParser struct {
-	*embeddedParser
-}
-
-func defaultFloat64SliceParser() *float64SliceParser {
-	return &float64SliceParser{&embeddedParser{}}
-}
-
-func (p *float64SliceParser) ParseCmd(input string) (any, error) {
-	var empty string
-	if input == empty {
-		return nil, errors.ErrNoValueProvided
-	}
-	s := strings.Split(input, p.Separator())
-	parsed := make([]float64, 0, len(s))
-	for _, el := range s {
-		v, err := strconv.ParseFloat(el, 64)
-		if err != nil {
-			return nil, err
-		}
-		parsed = append(parsed, v)
-	}
-	if p.IsSetFromCmd() {
-		stored := DerefOrDie[[]float64](p.CurrentValue())
-		parsed = append(stored, parsed...)
-	}
-	return &parsed, nil
-}
-
-func (p *float64SliceParser) ParseEnv(input string) (any, error) {
-	s := strings.Split(input, p.Separator())
-	parsed := make([]float64, 0, len(s))
-	for _, el := range s {
-		v, err := strconv.ParseFloat(el, 64)
-		if err != nil {
-			return nil, err
-		}
-		parsed = append(parsed, v)
-	}
-	return &parsed, nil
+func defaultFloat64SliceParser() *parser.SliceParser[float64] {
+	return parser.Slice(parser.Signed(parser.Func(parseFloat64)))
 }
 
 func Float64Slice(name string, opts ...Option) *Float64SliceFlag {
@@ -0,0 +1,151 @@
+package flag
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+const (
+	keyValueSeparator = "="
+	redactedValue     = "[REDACTED]"
+	// longFlagNamePrefix and inlineValueSeparator form the command-line arguments, e.g. `--name=value`.
+	longFlagNamePrefix   = "--"
+	inlineValueSeparator = "="
+)
+
+// FormatValue returns the string representation of the value, which may be parsed back
+// by the built-in parsers: text marshalers and stringers are formatted by themselves,
+// pointers are dereferenced, elements of slices are joined with the separator and maps
+// are formatted as sorted `key=value` pairs joined with the separator.
+func FormatValue(v any, separator string) string {
+	if v == nil {
+		return ""
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return ""
+	}
+	switch val := v.(type) {
+	case encoding.TextMarshaler:
+		text, err := val.MarshalText()
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(text)
+	case fmt.Stringer:
+		return val.String()
+	}
+	switch rv.Kind() {
+	case reflect.Pointer:
+		return FormatValue(rv.Elem().Interface(), separator)
+	case reflect.Slice:
+		elems := make([]string, 0, rv.Len())
+		for i := range rv.Len() {
+			elems = append(elems, FormatElem(rv.Index(i), separator))
+		}
+		return strings.Join(elems, separator)
+	case reflect.Map:
+		pairs := make([]string, 0, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			k := FormatElem(iter.Key(), separator)
+			pairs = append(pairs, k+keyValueSeparator+FormatElem(iter.Value(), separator))
+		}
+		slices.Sort(pairs)
+		return strings.Join(pairs, separator)
+	default:
+	}
+	return fmt.Sprint(v)
+}
+
+// FormatElem returns the string representation of the element or the map key of the slice
+// or map value, the same as FormatValue uses for the value.
+func FormatElem(v reflect.Value, separator string) string {
+	return FormatValue(addressOf(v), separator)
+}
+
+// addressOf returns the pointer to the value, so that the methods with pointer receivers,
+// such as String of url.URL, are used to format it. Values which are not addressable are copied.
+func addressOf(v reflect.Value) any {
+	if v.CanAddr() {
+		return v.Addr().Interface()
+	}
+	p := reflect.New(v.Type())
+	p.Elem().Set(v)
+	return p.Interface()
+}
+
+// String returns the string representation of the current value of the flag,
+// or the default one if the flag is not set. It returns an empty string if the flag has no value.
+// If the value was read from the file, it returns `@path`, or `-` for stdin.
+// The value of the secret flag is replaced with [REDACTED].
+func (f *flag[T]) String() string {
+	switch f.valueFile {
+	case "":
+	case stdinValue:
+		return stdinValue
+	default:
+		return valueFilePrefix + f.valueFile
+	}
+	v := f.value
+	if v == nil {
+		v = f.defaultValue
+	}
+	if v == nil {
+		return ""
+	}
+	if f.secret {
+		return redactedValue
+	}
+	return f.format(v)
+}
+
+// DefaultString returns the string representation of the default value of the flag, regardless
+// of the current one. It returns an empty string if the flag has no default value.
+// The default value of the secret flag is replaced with [REDACTED].
+func (f *flag[T]) DefaultString() string {
+	if f.defaultValue == nil {
+		return ""
+	}
+	if f.secret {
+		return redactedValue
+	}
+	return f.format(f.defaultValue)
+}
+
+// format returns the string representation of the value with the formatter of the flag,
+// if it is set, or with FormatValue otherwise.
+func (f *flag[T]) format(v *T) string {
+	if f.formatter != nil {
+		return f.formatter(*v)
+	}
+	return FormatValue(v, f.Separator())
+}
+
+// Arguments returns the command-line arguments setting the flag to its current value,
+// so that passing them to the parser of another flag of the same kind results in the same value.
+// The value is given inline, e.g. `--name=value`, and is not redacted for the secret flags.
+// The value read from the file is given as `@path`, while the one read from stdin is given as is.
+// If the flag is not set, the arguments set it to the default value.
+// It returns nil if the flag has no value.
+func (f *flag[T]) Arguments() []string {
+	value := f.value
+	if value == nil {
+		value = f.defaultValue
+	}
+	if value == nil {
+		return nil
+	}
+	v := f.format(value)
+	switch {
+	case f.valueFile != "" && f.valueFile != stdinValue:
+		v = valueFilePrefix + f.valueFile
+	case f.valueFromFile && strings.HasPrefix(v, valueFilePrefix):
+		v = valueFilePrefix + v
+	default:
+	}
+	return []string{longFlagNamePrefix + f.Name() + inlineValueSeparator + v}
+}
@@ -3,8 +3,10 @@ package flag
 import (
 	"fmt"
 	"os"
+	"slices"
 
 	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/parser"
 )
 
 const defaultSliceSeparator = ","
@@ -23,16 +25,32 @@ type flagParser interface {
 }
 
 type flag[T any] struct {
-	name         string
-	description  string
-	shorthand    string
-	shared       bool
-	defaultValue *T
-	value        *T
-	separator    string
-	parser       flagParser
-	setFromEnv   bool
-	setFromCmd   bool
+	name               string
+	description        string
+	shorthand          string
+	shared             bool
+	consumeNextArg     bool
+	negatable          bool
+	noArgValue         *string
+	repeatPolicy       RepeatPolicy
+	aliases            []string
+	deprecatedAliases  []string
+	hidden             bool
+	category           string
+	valueFromFile      bool
+	valueFile          string
+	secret             bool
+	formatter          func(any) string
+	defaultValue       *T
+	value              *T
+	separator          string
+	parser             flagParser
+	contextParser      parser.ContextParser
+	adaptedParser      parser.ContextParser
+	setFromEnv         bool
+	setFromCmd         bool
+	duplicateKeyPolicy DuplicateKeyPolicy
+	validators         []func(any) error
 }
 
 func (f *flag[T]) Value() any {
@@ -65,10 +83,94 @@ func (f *flag[T]) IsShared() bool {
 	return f.shared
 }
 
+// ConsumesNextArg reports whether the flag always takes the next command-line
+// argument as its value, even if the argument starts with a dash.
+func (f *flag[T]) ConsumesNextArg() bool {
+	return f.consumeNextArg
+}
+
+// NoArgValue returns the value used if the flag is given on the command line without
+// an argument. The second returned value is false if the flag has no such value.
+func (f *flag[T]) NoArgValue() (string, bool) {
+	if f.noArgValue == nil {
+		return "", false
+	}
+	return *f.noArgValue, true
+}
+
+// ValueFile returns the path of the file the current value of the flag was read from,
+// or "-" if it was read from stdin. It returns an empty string if the value was given directly.
+func (f *flag[T]) ValueFile() string {
+	return f.valueFile
+}
+
+// IsValueFromFile reports whether the value of the flag may be read from the file
+// given with the `@path` argument.
+func (f *flag[T]) IsValueFromFile() bool {
+	return f.valueFromFile
+}
+
+// IsSecret reports whether the value of the flag is redacted wherever it is displayed.
+func (f *flag[T]) IsSecret() bool {
+	return f.secret
+}
+
+// IsHidden reports whether the flag is hidden from help.
+func (f *flag[T]) IsHidden() bool {
+	return f.hidden
+}
+
+// Category returns the name of the group the flag belongs to in help.
+// It returns an empty string if the flag does not belong to any group.
+func (f *flag[T]) Category() string {
+	return f.category
+}
+
+// Aliases returns the alternative long names of the flag.
+func (f *flag[T]) Aliases() []string {
+	return slices.Clone(f.aliases)
+}
+
+// DeprecatedAliases returns the deprecated long names of the flag,
+// which are still accepted, but are not shown in help.
+func (f *flag[T]) DeprecatedAliases() []string {
+	return slices.Clone(f.deprecatedAliases)
+}
+
+// RepeatPolicy returns the policy for repeated command-line values of the flag.
+func (f *flag[T]) RepeatPolicy() RepeatPolicy {
+	return f.repeatPolicy
+}
+
+// IsNegatable reports whether the flag may be set to false with the `--no-<name>` form.
+func (f *flag[T]) IsNegatable() bool {
+	return f.negatable
+}
+
+// IsBoolFlag reports whether the flag takes no separate command-line argument,
+// like a boolean or a counter. The flag is parsed from an empty input instead.
+func (f *flag[T]) IsBoolFlag() bool {
+	b, ok := f.activeParser().(parser.BoolFlagParser)
+	return ok && b.IsBoolFlag()
+}
+
+// AcceptsLeadingDash reports whether the flag accepts the command-line value which starts
+// with a dash, like a negative number.
+func (f *flag[T]) AcceptsLeadingDash() bool {
+	d, ok := f.activeParser().(parser.LeadingDashParser)
+	return ok && d.AcceptsLeadingDash()
+}
+
 func (f *flag[T]) Parser() flagParser {
 	return f.parser
 }
 
+// Choices returns the list of values accepted by the flag.
+// It returns nil, as the flag accepts any value, unless it is created with Enum or Choice.
+func (f *flag[T]) Choices() []string {
+	return nil
+}
+
 func (f *flag[T]) IsSetFromEnv() bool {
 	return f.setFromEnv
 }
@@ -93,6 +195,50 @@ func (f *flag[T]) setShared() {
 	f.shared = true
 }
 
+func (f *flag[T]) setConsumeNextArg() {
+	f.consumeNextArg = true
+}
+
+func (f *flag[T]) setValueFromFile() {
+	f.valueFromFile = true
+}
+
+func (f *flag[T]) setFormatter(fn func(any) string) {
+	f.formatter = fn
+}
+
+func (f *flag[T]) setSecret() {
+	f.secret = true
+}
+
+func (f *flag[T]) setHidden() {
+	f.hidden = true
+}
+
+func (f *flag[T]) setCategory(category string) {
+	f.category = category
+}
+
+func (f *flag[T]) addAliases(aliases []string) {
+	f.aliases = append(f.aliases, aliases...)
+}
+
+func (f *flag[T]) addDeprecatedAliases(aliases []string) {
+	f.deprecatedAliases = append(f.deprecatedAliases, aliases...)
+}
+
+func (f *flag[T]) setRepeatPolicy(policy RepeatPolicy) {
+	f.repeatPolicy = policy
+}
+
+func (f *flag[T]) setNoArgValue(value string) {
+	f.noArgValue = &value
+}
+
+func (f *flag[T]) setNoNegation() {
+	f.negatable = false
+}
+
 func (f *flag[T]) setDefaultValue(value any) {
 	var v T
 	switch val := value.(type) {
@@ -111,26 +257,78 @@ func (f *flag[T]) setSeparator(separator string) {
 
 func (f *flag[T]) setParser(p flagParser) {
 	f.parser = p
+	f.adaptedParser = nil
+	if p != nil {
+		f.adaptedParser = parser.Adapt(p)
+	}
+}
+
+func (f *flag[T]) setContextParser(p parser.ContextParser) {
+	f.contextParser = p
+}
+
+func (f *flag[T]) setDuplicateKeyPolicy(policy DuplicateKeyPolicy) {
+	f.duplicateKeyPolicy = policy
 }
 
-func (f *flag[T]) reflectStateToParser() {
-	f.parser.SetFromEnv(f.IsSetFromEnv())
-	f.parser.SetFromCmd(f.IsSetFromCmd())
-	f.parser.SetSeparator(f.Separator())
-	f.parser.SetCurrentValue(f.value)
+func (f *flag[T]) addValidator(v func(any) error) {
+	f.validators = append(f.validators, v)
 }
 
-func (f *flag[T]) parseInput(input string, parseFunc func(string) (any, error)) error {
+func (f *flag[T]) validate(v *T) error {
+	for _, validate := range f.validators {
+		if err := validate(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkDefaultValue exits if the default value of the flag is rejected by its validators,
+// like it does for other errors in the flag definition.
+func (f *flag[T]) checkDefaultValue() {
+	if f.defaultValue == nil {
+		return
+	}
+	if err := f.validate(f.defaultValue); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: invalid default value of flag %q: %v\n", f.name, err)
+		os.Exit(1)
+	}
+}
+
+// activeParser returns the parser which parses the input of the flag: the context parser,
+// if set, otherwise the flag parser. It is nil if neither is set.
+func (f *flag[T]) activeParser() any {
+	if f.contextParser != nil {
+		return f.contextParser
+	}
+	if f.parser != nil {
+		return f.parser
+	}
+	return nil
+}
+
+// inputParser returns the context parser of the flag, if set, otherwise the flag parser
+// adapted to the context parser interface once it was set. Every flag adapts its parser
+// on its own, so the calls are serialized per flag only.
+func (f *flag[T]) inputParser() parser.ContextParser {
+	if f.contextParser != nil {
+		return f.contextParser
+	}
+	return f.adaptedParser
+}
+
+func (f *flag[T]) parseInput(ctx parser.Context, input string) error {
 	var (
 		val    any
 		parsed *T
 		err    error
 	)
-	if f.parser == nil {
-		return errors.ErrNoParserDefined
+	p := f.inputParser()
+	if p == nil {
+		return errors.NoParserDefined(f.Name())
 	}
-	f.reflectStateToParser()
-	val, err = parseFunc(input)
+	val, err = p.Parse(ctx, input)
 	if err != nil {
 		return errors.ParseError(f.Name(), err)
 	}
@@ -138,28 +336,70 @@ func (f *flag[T]) parseInput(input string, parseFunc func(string) (any, error))
 	if err != nil {
 		return errors.ParseError(f.Name(), err)
 	}
+	if err = f.validate(parsed); err != nil {
+		return errors.ParseError(f.Name(), err)
+	}
 	f.value = parsed
 	return nil
 }
 
-func (f *flag[T]) FromCommandLine(input string) error {
-	if f.parser == nil {
-		return errors.NoParserDefined(f.Name())
+// ParseContext parses the input coming from the source defined by the context.
+// Flag name, separator, current value and the state of the flag are filled in by the flag itself.
+// Repeated command-line values are handled according to the repeat policy of the flag,
+// or the one from the context if the flag has the default policy. Values read from files
+// are never accumulated with the repeated ones.
+func (f *flag[T]) ParseContext(ctx parser.Context, input string) error {
+	ctx.FlagName = f.Name()
+	ctx.Separator = f.Separator()
+	ctx.CurrentValue = f.value
+	ctx.IsSetFromCmd = f.IsSetFromCmd()
+	ctx.IsSetFromEnv = f.IsSetFromEnv()
+	if ctx.Source == parser.SourceCommandLine && f.IsSetFromCmd() {
+		policy := f.repeatPolicy
+		if policy == RepeatDefault {
+			policy = ctx.RepeatPolicy
+		}
+		switch policy {
+		case RepeatError:
+			return errors.FlagVisited(f.Name())
+		case RepeatFirstWins:
+			return nil
+		case RepeatLastWins:
+			ctx.IsSetFromCmd = false
+			ctx.CurrentValue = f.defaultValue
+		default:
+			// The source of the accumulated value is kept for one occurrence only.
+			if f.valueFromFile && (f.valueFile != "" || isValueFileRef(input)) {
+				return errors.ValueFileRepeated(f.Name())
+			}
+		}
 	}
-	err := f.parseInput(input, f.parser.ParseCmd)
-	if err == nil {
+	var file string
+	if f.valueFromFile {
+		var err error
+		if input, file, err = readValueFile(input); err != nil {
+			return errors.ParseError(f.Name(), err)
+		}
+	}
+	if err := f.parseInput(ctx, input); err != nil {
+		if f.secret {
+			return errors.Redact(f.Name(), err)
+		}
+		return err
+	}
+	f.valueFile = file
+	if ctx.Source == parser.SourceEnvironment {
+		f.setFromEnv = true
+	} else {
 		f.setFromCmd = true
 	}
-	return err
+	return nil
+}
+
+func (f *flag[T]) FromCommandLine(input string) error {
+	return f.ParseContext(parser.Context{Source: parser.SourceCommandLine, ArgIndex: -1}, input)
 }
 
 func (f *flag[T]) FromEnvVariable(input string) error {
-	if f.parser == nil {
-		return errors.NoParserDefined(f.Name())
-	}
-	err := f.parseInput(input, f.parser.ParseEnv)
-	if err == nil {
-		f.setFromEnv = true
-	}
-	return err
+	return f.ParseContext(parser.Context{Source: parser.SourceEnvironment, ArgIndex: -1}, input)
 }
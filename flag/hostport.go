@@ -0,0 +1,55 @@
+package flag
+
+import (
+	"net"
+	"strconv"
+
+	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/parser"
+)
+
+// Endpoint is a network endpoint in the `host:port` form.
+// Host is either a host name or an IP address, and may be empty.
+type Endpoint struct {
+	Host string
+	Port uint16
+}
+
+func (e Endpoint) String() string {
+	return net.JoinHostPort(e.Host, strconv.FormatUint(uint64(e.Port), 10))
+}
+
+// ParseEndpoint parses a `host:port` string. IPv6 hosts have to be enclosed in square brackets.
+func ParseEndpoint(input string) (Endpoint, error) {
+	host, port, err := net.SplitHostPort(input)
+	if err != nil {
+		return Endpoint{}, err
+	}
+	if port == "" {
+		return Endpoint{}, errors.InvalidValue(input, "port is missing")
+	}
+	p, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return Endpoint{}, errors.InvalidValue(input, "port must be a number between 0 and 65535")
+	}
+	return Endpoint{Host: host, Port: uint16(p)}, nil
+}
+
+type hostPort = flag[Endpoint]
+
+type HostPortFlag struct {
+	*hostPort
+}
+
+func defaultHostPortParser() *parser.FuncParser[Endpoint] {
+	return parser.Func(ParseEndpoint)
+}
+
+func HostPort(name string, opts ...Option) *HostPortFlag {
+	f := newFlag[Endpoint](name)
+	applyForFlag(addressSetter[Endpoint]{f}, opts...)
+	if f.Parser() == nil {
+		f.setParser(defaultHostPortParser())
+	}
+	return &HostPortFlag{f}
+}
@@ -0,0 +1,22 @@
+package flag
+
+import "github.com/brongineer/helium/parser"
+
+type hostPortSlice = flag[[]Endpoint]
+
+type HostPortSliceFlag struct {
+	*hostPortSlice
+}
+
+func defaultHostPortSliceParser() *parser.SliceParser[Endpoint] {
+	return parser.Slice(parser.Func(ParseEndpoint))
+}
+
+func HostPortSlice(name string, opts ...Option) *HostPortSliceFlag {
+	f := newFlag[[]Endpoint](name)
+	applyForFlag(addressSetter[[]Endpoint]{f}, opts...)
+	if f.Parser() == nil {
+		f.setParser(defaultHostPortSliceParser())
+	}
+	return &HostPortSliceFlag{f}
+}
@@ -35,6 +35,11 @@ func (p *intParser) ParseCmd(input string) (any, error) {
 	return &parsed, nil
 }
 
+// AcceptsLeadingDash reports that the flag accepts negative values.
+func (p *intParser) AcceptsLeadingDash() bool {
+	return true
+}
+
 func (p *intParser) ParseEnv(input string) (any, error) {
 	var (
 		parsed int
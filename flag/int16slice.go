@@ -1,10 +1,7 @@
 package flag
 
 import (
-	"strconv"
-	"strings"
-
-	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/parser"
 )
 
 type int16Slice = flag[[]int16]
@@ -13,46 +10,8 @@ type Int16SliceFlag struct {
 	*int16Slice
 }
 
-type int16SliceParser struct {
-	*embeddedParser
-}
-
-func defaultInt16SliceParser() *int16SliceParser {
-	return &int16SliceParser{&embeddedParser{}}
-}
-
-func (p *int16SliceParser) ParseCmd(input string) (any, error) {
-	var empty string
-	if input == empty {
-		return nil, errors.ErrNoValueProvided
-	}
-	s := strings.Split(input, p.Separator())
-	parsed := make([]int16, 0, len(s))
-	for _, el := range s {
-		v, err := strconv.ParseInt(el, 10, 16)
-		if err != nil {
-			return nil, err
-		}
-		parsed = append(parsed, int16(v))
-	}
-	if p.IsSetFromCmd() {
-		stored := DerefOrDie[[]int16](p.CurrentValue())
-		parsed = append(stored, parsed...)
-	}
-	return &parsed, nil
-}
-
-func (p *int16SliceParser) ParseEnv(input string) (any, error) {
-	s := strings.Split(input, p.Separator())
-	parsed := make([]int16, 0, len(s))
-	for _, el := range s {
-		v, err := strconv.ParseInt(el, 10, 16)
-		if err != nil {
-			return nil, err
-		}
-		parsed = append(parsed, int16(v))
-	}
-	return &parsed, nil
+func defaultInt16SliceParser() *parser.SliceParser[int16] {
+	return parser.Slice(parser.Signed(parser.Func(parseInt16)))
 }
 
 func Int16Slice(name string, opts ...Option) *Int16SliceFlag {
@@ -36,6 +36,11 @@ func (p *int32Parser) ParseCmd(input string) (any, error) {
 	return &parsed, nil
 }
 
+// AcceptsLeadingDash reports that the flag accepts negative values.
+func (p *int32Parser) AcceptsLeadingDash() bool {
+	return true
+}
+
 func (p *int32Parser) ParseEnv(value string) (any, error) {
 	var (
 		v   int64
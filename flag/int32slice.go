@@ -1,10 +1,7 @@
 package flag
 
 import (
-	"strconv"
-	"strings"
-
-	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/parser"
 )
 
 type int32Slice = flag[[]int32]
@@ -13,46 +10,8 @@ type Int32SliceFlag struct {
 	*int32Slice
 }
 
-type int32SliceParser struct {
-	*embeddedParser
-}
-
-func defaultInt32SliceParser() *int32SliceParser {
-	return &int32SliceParser{&embeddedParser{}}
-}
-
-func (p *int32SliceParser) ParseCmd(input string) (any, error) {
-	var empty string
-	if input == empty {
-		return nil, errors.ErrNoValueProvided
-	}
-	s := strings.Split(input, p.Separator())
-	parsed := make([]int32, 0, len(s))
-	for _, el := range s {
-		v, err := strconv.ParseInt(el, 10, 32)
-		if err != nil {
-			return nil, err
-		}
-		parsed = append(parsed, int32(v))
-	}
-	if p.IsSetFromCmd() {
-		stored := DerefOrDie[[]int32](p.CurrentValue())
-		parsed = append(stored, parsed...)
-	}
-	return &parsed, nil
-}
-
-func (p *int32SliceParser) ParseEnv(input string) (any, error) {
-	s := strings.Split(input, p.Separator())
-	parsed := make([]int32, 0, len(s))
-	for _, el := range s {
-		v, err := strconv.ParseInt(el, 10, 32)
-		if err != nil {
-			return nil, err
-		}
-		parsed = append(parsed, int32(v))
-	}
-	return &parsed, nil
+func defaultInt32SliceParser() *parser.SliceParser[int32] {
+	return parser.Slice(parser.Signed(parser.Func(parseInt32)))
 }
 
 func Int32Slice(name string, opts ...Option) *Int32SliceFlag {
@@ -36,6 +36,11 @@ func (p *int64Parser) ParseCmd(input string) (any, error) {
 	return &parsed, nil
 }
 
+// AcceptsLeadingDash reports that the flag accepts negative values.
+func (p *int64Parser) AcceptsLeadingDash() bool {
+	return true
+}
+
 func (p *int64Parser) ParseEnv(value string) (any, error) {
 	var (
 		parsed int64
@@ -1,10 +1,7 @@
 package flag
 
 import (
-	"strconv"
-	"strings"
-
-	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/parser"
 )
 
 type int64Slice = flag[[]int64]
@@ -13,46 +10,8 @@ type Int64SliceFlag struct {
 	*int64Slice
 }
 
-type int64SliceParser struct {
-	*embeddedParser
-}
-
-func defaultInt64SliceParser() *int64SliceParser {
-	return &int64SliceParser{&embeddedParser{}}
-}
-
-func (p *int64SliceParser) ParseCmd(input string) (any, error) {
-	var empty string
-	if input == empty {
-		return nil, errors.ErrNoValueProvided
-	}
-	s := strings.Split(input, p.Separator())
-	parsed := make([]int64, 0, len(s))
-	for _, el := range s {
-		v, err := strconv.ParseInt(el, 10, 64)
-		if err != nil {
-			return nil, err
-		}
-		parsed = append(parsed, v)
-	}
-	if p.IsSetFromCmd() {
-		stored := DerefOrDie[[]int64](p.CurrentValue())
-		parsed = append(stored, parsed...)
-	}
-	return &parsed, nil
-}
-
-func (p *int64SliceParser) ParseEnv(input string) (any, error) {
-	s := strings.Split(input, p.Separator())
-	parsed := make([]int64, 0, len(s))
-	for _, el := range s {
-		v, err := strconv.ParseInt(el, 10, 64)
-		if err != nil {
-			return nil, err
-		}
-		parsed = append(parsed, v)
-	}
-	return &parsed, nil
+func defaultInt64SliceParser() *parser.SliceParser[int64] {
+	return parser.Slice(parser.Signed(parser.Func(parseInt64)))
 }
 
 func Int64Slice(name string, opts ...Option) *Int64SliceFlag {
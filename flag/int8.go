@@ -36,6 +36,11 @@ func (p *int8Parser) ParseCmd(input string) (any, error) {
 	return &parsed, nil
 }
 
+// AcceptsLeadingDash reports that the flag accepts negative values.
+func (p *int8Parser) AcceptsLeadingDash() bool {
+	return true
+}
+
 func (p *int8Parser) ParseEnv(value string) (any, error) {
 	var (
 		v   int64
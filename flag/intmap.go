@@ -0,0 +1,11 @@
+package flag
+
+type intMap = flag[map[string]int]
+
+type IntMapFlag struct {
+	*intMap
+}
+
+func IntMap(name string, opts ...Option) *IntMapFlag {
+	return &IntMapFlag{newMapFlag[string, int](name, opts...)}
+}
@@ -2,9 +2,8 @@ package flag
 
 import (
 	"strconv"
-	"strings"
 
-	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/parser"
 )
 
 type intSlice = flag[[]int]
@@ -13,46 +12,8 @@ type IntSliceFlag struct {
 	*intSlice
 }
 
-type intSliceParser struct {
-	*embeddedParser
-}
-
-func defaultIntSliceParser() *intSliceParser {
-	return &intSliceParser{&embeddedParser{}}
-}
-
-func (p *intSliceParser) ParseCmd(input string) (any, error) {
-	var empty string
-	if input == empty {
-		return nil, errors.ErrNoValueProvided
-	}
-	s := strings.Split(input, p.Separator())
-	parsed := make([]int, 0, len(s))
-	for _, el := range s {
-		v, err := strconv.Atoi(el)
-		if err != nil {
-			return nil, err
-		}
-		parsed = append(parsed, v)
-	}
-	if p.IsSetFromCmd() {
-		stored := DerefOrDie[[]int](p.CurrentValue())
-		parsed = append(stored, parsed...)
-	}
-	return &parsed, nil
-}
-
-func (p *intSliceParser) ParseEnv(input string) (any, error) {
-	s := strings.Split(input, p.Separator())
-	parsed := make([]int, 0, len(s))
-	for _, el := range s {
-		v, err := strconv.Atoi(el)
-		if err != nil {
-			return nil, err
-		}
-		parsed = append(parsed, v)
-	}
-	return &parsed, nil
+func defaultIntSliceParser() *parser.SliceParser[int] {
+	return parser.Slice(parser.Signed(parser.Func(strconv.Atoi)))
 }
 
 func IntSlice(name string, opts ...Option) *IntSliceFlag {
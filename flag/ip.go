@@ -0,0 +1,81 @@
+package flag
+
+import (
+	"net/netip"
+
+	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/parser"
+)
+
+type ip = flag[netip.Addr]
+
+type IPFlag struct {
+	*ip
+}
+
+func defaultIPParser() *parser.FuncParser[netip.Addr] {
+	return parser.Func(netip.ParseAddr)
+}
+
+// ipFamilyValidator returns a validator checking every address held by the
+// IP, Prefix and HostPort flags with the given predicate.
+func ipFamilyValidator(check func(netip.Addr) bool, reason string) func(any) error {
+	validate := func(addr netip.Addr) error {
+		if !check(addr.Unmap()) {
+			return errors.InvalidValue(addr.String(), reason)
+		}
+		return nil
+	}
+	validateEndpoint := func(e Endpoint) error {
+		addr, err := netip.ParseAddr(e.Host)
+		if err != nil {
+			return nil
+		}
+		return validate(addr)
+	}
+	return func(v any) error {
+		switch val := v.(type) {
+		case *netip.Addr:
+			return validate(*val)
+		case *[]netip.Addr:
+			return validateEach(*val, validate)
+		case *netip.Prefix:
+			return validate(val.Addr())
+		case *[]netip.Prefix:
+			return validateEach(*val, func(p netip.Prefix) error { return validate(p.Addr()) })
+		case *Endpoint:
+			return validateEndpoint(*val)
+		case *[]Endpoint:
+			return validateEach(*val, validateEndpoint)
+		}
+		return nil
+	}
+}
+
+// addressSetter accepts the options specific to the flags holding IP addresses
+// along with the common ones.
+type addressSetter[T any] struct {
+	*flag[T]
+}
+
+func (s addressSetter[T]) addAddressValidator(v func(any) error) {
+	s.addValidator(v)
+}
+
+func validateEach[T any](values []T, validate func(T) error) error {
+	for _, v := range values {
+		if err := validate(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func IP(name string, opts ...Option) *IPFlag {
+	f := newFlag[netip.Addr](name)
+	applyForFlag(addressSetter[netip.Addr]{f}, opts...)
+	if f.Parser() == nil {
+		f.setParser(defaultIPParser())
+	}
+	return &IPFlag{f}
+}
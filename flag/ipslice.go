@@ -0,0 +1,26 @@
+package flag
+
+import (
+	"net/netip"
+
+	"github.com/brongineer/helium/parser"
+)
+
+type ipSlice = flag[[]netip.Addr]
+
+type IPSliceFlag struct {
+	*ipSlice
+}
+
+func defaultIPSliceParser() *parser.SliceParser[netip.Addr] {
+	return parser.Slice(parser.Func(netip.ParseAddr))
+}
+
+func IPSlice(name string, opts ...Option) *IPSliceFlag {
+	f := newFlag[[]netip.Addr](name)
+	applyForFlag(addressSetter[[]netip.Addr]{f}, opts...)
+	if f.Parser() == nil {
+		f.setParser(defaultIPSliceParser())
+	}
+	return &IPSliceFlag{f}
+}
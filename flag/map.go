@@ -0,0 +1,49 @@
+package flag
+
+import (
+	"github.com/brongineer/helium/parser"
+)
+
+// DuplicateKeyPolicy defines how map flags handle a key which is provided more than once.
+type DuplicateKeyPolicy int
+
+const (
+	// DuplicateKeyLastWins keeps the value provided last for the repeated key.
+	DuplicateKeyLastWins DuplicateKeyPolicy = iota
+	// DuplicateKeyError makes parsing fail if the key is provided more than once.
+	DuplicateKeyError
+)
+
+type MapFlag[K comparable, V any] struct {
+	*flag[map[K]V]
+}
+
+func defaultMapParser[K comparable, V any](policy DuplicateKeyPolicy) *parser.MapParser[K, V] {
+	parseKey, keyOk := scalarParseFunc[K]()
+	parseValue, valueOk := scalarParseFunc[V]()
+	if !keyOk || !valueOk {
+		return nil
+	}
+	if policy == DuplicateKeyError {
+		return parser.StrictMap(parser.Func(parseKey), parser.Func(parseValue))
+	}
+	return parser.Map(parser.Func(parseKey), parser.Func(parseValue))
+}
+
+func newMapFlag[K comparable, V any](name string, opts ...Option) *flag[map[K]V] {
+	f := newFlag[map[K]V](name)
+	applyForFlag(f, opts...)
+	if f.Parser() == nil {
+		if p := defaultMapParser[K, V](f.duplicateKeyPolicy); p != nil {
+			f.setParser(p)
+		}
+	}
+	return f
+}
+
+// Map creates a flag holding a map[K]V, parsed from `key=value` pairs.
+// Built-in parsers are provided if both K and V are types supported by
+// the scalar flags, otherwise a custom parser has to be set with the Parser option.
+func Map[K comparable, V any](name string, opts ...Option) *MapFlag[K, V] {
+	return &MapFlag[K, V]{newMapFlag[K, V](name, opts...)}
+}
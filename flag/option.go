@@ -1,12 +1,59 @@
 package flag
 
+import (
+	"fmt"
+	"net/netip"
+	"os"
+	"time"
+
+	"github.com/brongineer/helium/parser"
+)
+
 type flagPropertySetter interface {
 	setDescription(string)
 	setShorthand(string)
 	setShared()
+	setConsumeNextArg()
+	setNoNegation()
+	setNoArgValue(string)
+	setRepeatPolicy(RepeatPolicy)
+	addAliases([]string)
+	addDeprecatedAliases([]string)
+	setHidden()
+	setValueFromFile()
+	setSecret()
+	setFormatter(func(any) string)
+	setCategory(string)
 	setDefaultValue(any)
 	setSeparator(string)
 	setParser(flagParser)
+	setContextParser(parser.ContextParser)
+	setDuplicateKeyPolicy(DuplicateKeyPolicy)
+	checkDefaultValue()
+	Name() string
+}
+
+// enumPropertySetter is implemented by the setters of the flags created with Enum or Choice.
+type enumPropertySetter interface {
+	setIgnoreCase()
+	setChoiceAlias(string, string)
+}
+
+// addressPropertySetter is implemented by the setters of the IP, Prefix and HostPort flags
+// and their slices.
+type addressPropertySetter interface {
+	addAddressValidator(func(any) error)
+}
+
+// urlPropertySetter is implemented by the setters of the URL flags and their slices.
+type urlPropertySetter interface {
+	addURLValidator(func(any) error)
+}
+
+// timePropertySetter is implemented by the setter of the time flags.
+type timePropertySetter interface {
+	setTimeLayouts([]string)
+	setLocation(*time.Location)
 }
 
 type Option interface {
@@ -47,6 +94,140 @@ func Shared() Option {
 	return shared{}
 }
 
+type consumeNextArg struct{}
+
+func (c consumeNextArg) apply(f flagPropertySetter) {
+	f.setConsumeNextArg()
+}
+
+// ConsumeNextArg makes the flag always take exactly one next command-line argument
+// as its value, even if the argument starts with a dash, e.g. `--pattern -foo`.
+func ConsumeNextArg() Option {
+	return consumeNextArg{}
+}
+
+type valueFromFile struct{}
+
+func (v valueFromFile) apply(f flagPropertySetter) {
+	f.setValueFromFile()
+}
+
+// ValueFromFile makes the flag read its value from the file, if the value is `@path`,
+// or from stdin, if the value is `-`, before passing it to the parser. A single trailing
+// newline is removed. A value starting with `@@` is passed with the first at-sign removed.
+// The flag keeps the path, so that String shows `@path` rather than the content.
+// Repeated values of the accumulating flags, such as slices, are rejected with
+// errors.ErrValueFileRepeated, if any of them is read from the file, unless the flag
+// keeps either the first or the last of them (see Repeat).
+func ValueFromFile() Option {
+	return valueFromFile{}
+}
+
+type secret struct{}
+
+func (s secret) apply(f flagPropertySetter) {
+	f.setSecret()
+}
+
+// Secret marks the value of the flag as secret, e.g. a password or a token. The value
+// is redacted in String, and therefore in help and exports, and in parse errors,
+// while it is still available through Value and the flag set getters.
+func Secret() Option {
+	return secret{}
+}
+
+type hidden struct{}
+
+func (h hidden) apply(f flagPropertySetter) {
+	f.setHidden()
+}
+
+// Hidden hides the flag from help, e.g. for internal flags. Hidden flags are parsed as usual.
+func Hidden() Option {
+	return hidden{}
+}
+
+type category struct {
+	name string
+}
+
+func (c category) apply(f flagPropertySetter) {
+	f.setCategory(c.name)
+}
+
+// Category puts the flag into the named group in help.
+func Category(name string) Option {
+	return category{name}
+}
+
+type aliases struct {
+	names []string
+}
+
+func (a aliases) apply(f flagPropertySetter) {
+	f.addAliases(a.names)
+}
+
+// Aliases adds alternative long names of the flag.
+func Aliases(names ...string) Option {
+	return aliases{names}
+}
+
+type deprecatedAliases struct {
+	names []string
+}
+
+func (d deprecatedAliases) apply(f flagPropertySetter) {
+	f.addDeprecatedAliases(d.names)
+}
+
+// DeprecatedAliases adds deprecated long names of the flag, e.g. the names used before
+// the flag was renamed. They are accepted with a warning naming the flag, and are not
+// shown in help.
+func DeprecatedAliases(names ...string) Option {
+	return deprecatedAliases{names}
+}
+
+type repeat struct {
+	policy RepeatPolicy
+}
+
+func (r repeat) apply(f flagPropertySetter) {
+	f.setRepeatPolicy(r.policy)
+}
+
+// Repeat sets the policy for repeated command-line values of the flag,
+// overriding the one of the flag set.
+func Repeat(policy RepeatPolicy) Option {
+	return repeat{policy}
+}
+
+type noArgValue struct {
+	value string
+}
+
+func (n noArgValue) apply(f flagPropertySetter) {
+	f.setNoArgValue(n.value)
+}
+
+// NoArgValue makes the argument of the flag optional: if the flag is given on the command
+// line without an inline value, e.g. `--color` rather than `--color=never`, the flag is parsed
+// from the given value, and the following argument is not taken as the flag value.
+func NoArgValue(value string) Option {
+	return noArgValue{value}
+}
+
+type noNegation struct{}
+
+func (n noNegation) apply(f flagPropertySetter) {
+	f.setNoNegation()
+}
+
+// NoNegation disables the `--no-<name>` form, which sets boolean flags to false.
+func NoNegation() Option {
+	return noNegation{}
+}
+
 type defaultValue struct {
 	value any
 }
@@ -76,15 +257,182 @@ type fParser struct {
 }
 
 func (o fParser) apply(f flagPropertySetter) {
-	f.setParser(o)
+	f.setParser(o.flagParser)
 }
 
+// Parser sets the parser of the flag input. The parser keeps the state of the flag while parsing,
+// so it should not be shared between flags which may be parsed concurrently. A parser shared
+// between flags should implement parser.ContextParser and be set with the ContextParser option.
 func Parser(p flagParser) Option {
 	return fParser{p}
 }
 
+type contextParser struct {
+	parser.ContextParser
+}
+
+func (o contextParser) apply(f flagPropertySetter) {
+	f.setContextParser(o)
+}
+
+// ContextParser sets the parser receiving the parse context along with the input.
+// It takes precedence over the parser set with the Parser option and the built-in one.
+func ContextParser(p parser.ContextParser) Option {
+	return contextParser{p}
+}
+
+type formatter struct {
+	fn func(any) string
+}
+
+func (o formatter) apply(f flagPropertySetter) {
+	f.setFormatter(o.fn)
+}
+
+// Formatter sets the function returning the string representation of the flag value,
+// which has to be accepted by the parser of the flag. It is used by String and Arguments
+// instead of the built-in formatting, which relies on encoding.TextMarshaler and fmt.Stringer
+// implemented by the value type. The function receives the value, not the pointer to it.
+func Formatter(fn func(value any) string) Option {
+	return formatter{fn}
+}
+
+type duplicateKeys struct {
+	policy DuplicateKeyPolicy
+}
+
+func (d duplicateKeys) apply(f flagPropertySetter) {
+	f.setDuplicateKeyPolicy(d.policy)
+}
+
+func DuplicateKeys(policy DuplicateKeyPolicy) Option {
+	return duplicateKeys{policy}
+}
+
+type ignoreCase struct{}
+
+func (i ignoreCase) apply(f flagPropertySetter) {
+	s, ok := f.(enumPropertySetter)
+	if !ok {
+		unsupportedOption(f, "IgnoreCase")
+		return
+	}
+	s.setIgnoreCase()
+}
+
+// IgnoreCase makes enum flags accept their choices and aliases regardless of the letter case.
+func IgnoreCase() Option {
+	return ignoreCase{}
+}
+
+type choiceAlias struct {
+	alias  string
+	choice string
+}
+
+func (c choiceAlias) apply(f flagPropertySetter) {
+	s, ok := f.(enumPropertySetter)
+	if !ok {
+		unsupportedOption(f, "ChoiceAlias")
+		return
+	}
+	s.setChoiceAlias(c.alias, c.choice)
+}
+
+// ChoiceAlias makes enum flags accept alias as an alternative spelling of the choice.
+func ChoiceAlias(alias, choice string) Option {
+	return choiceAlias{alias, choice}
+}
+
+type addressValidator struct {
+	name     string
+	validate func(any) error
+}
+
+func (v addressValidator) apply(f flagPropertySetter) {
+	s, ok := f.(addressPropertySetter)
+	if !ok {
+		unsupportedOption(f, v.name)
+		return
+	}
+	s.addAddressValidator(v.validate)
+}
+
+// IPv4Only restricts IP, Prefix and HostPort flags (and their slices) to IPv4 addresses.
+func IPv4Only() Option {
+	return addressValidator{"IPv4Only", ipFamilyValidator(netip.Addr.Is4, "IPv4 address required")}
+}
+
+// IPv6Only restricts IP, Prefix and HostPort flags (and their slices) to IPv6 addresses.
+func IPv6Only() Option {
+	return addressValidator{"IPv6Only", ipFamilyValidator(netip.Addr.Is6, "IPv6 address required")}
+}
+
+type urlSchemes struct {
+	schemes []string
+}
+
+func (u urlSchemes) apply(f flagPropertySetter) {
+	s, ok := f.(urlPropertySetter)
+	if !ok {
+		unsupportedOption(f, "URLSchemes")
+		return
+	}
+	s.addURLValidator(urlSchemeValidator(u.schemes))
+}
+
+// URLSchemes restricts URL flags (and their slices) to the given schemes.
+func URLSchemes(schemes ...string) Option {
+	return urlSchemes{schemes}
+}
+
+type timeLayouts struct {
+	layouts []string
+}
+
+func (t timeLayouts) apply(f flagPropertySetter) {
+	s, ok := f.(timePropertySetter)
+	if !ok {
+		unsupportedOption(f, "TimeLayouts")
+		return
+	}
+	s.setTimeLayouts(t.layouts)
+}
+
+// TimeLayouts sets the layouts accepted by time flags, tried in the given order.
+func TimeLayouts(layouts ...string) Option {
+	return timeLayouts{layouts}
+}
+
+type location struct {
+	loc *time.Location
+}
+
+func (l location) apply(f flagPropertySetter) {
+	s, ok := f.(timePropertySetter)
+	if !ok {
+		unsupportedOption(f, "Location")
+		return
+	}
+	s.setLocation(l.loc)
+}
+
+// Location sets the time zone used by time flags for values without an explicit
+// time zone and for the relative expressions.
+func Location(loc *time.Location) Option {
+	return location{loc}
+}
+
+// unsupportedOption exits, as the option is not applicable to the type of the flag,
+// like it does for other errors in the flag definition.
+func unsupportedOption(f flagPropertySetter, option string) {
+	_, _ = fmt.Fprintf(os.Stderr, "Error: option %s is not supported by flag %q\n", option, f.Name())
+	os.Exit(1)
+}
+
 func applyForFlag(f flagPropertySetter, opts ...Option) {
 	for _, opt := range opts {
 		opt.apply(f)
 	}
+	f.checkDefaultValue()
 }
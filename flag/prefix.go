@@ -0,0 +1,26 @@
+package flag
+
+import (
+	"net/netip"
+
+	"github.com/brongineer/helium/parser"
+)
+
+type prefix = flag[netip.Prefix]
+
+type PrefixFlag struct {
+	*prefix
+}
+
+func defaultPrefixParser() *parser.FuncParser[netip.Prefix] {
+	return parser.Func(netip.ParsePrefix)
+}
+
+func Prefix(name string, opts ...Option) *PrefixFlag {
+	f := newFlag[netip.Prefix](name)
+	applyForFlag(addressSetter[netip.Prefix]{f}, opts...)
+	if f.Parser() == nil {
+		f.setParser(defaultPrefixParser())
+	}
+	return &PrefixFlag{f}
+}
@@ -0,0 +1,26 @@
+package flag
+
+import (
+	"net/netip"
+
+	"github.com/brongineer/helium/parser"
+)
+
+type prefixSlice = flag[[]netip.Prefix]
+
+type PrefixSliceFlag struct {
+	*prefixSlice
+}
+
+func defaultPrefixSliceParser() *parser.SliceParser[netip.Prefix] {
+	return parser.Slice(parser.Func(netip.ParsePrefix))
+}
+
+func PrefixSlice(name string, opts ...Option) *PrefixSliceFlag {
+	f := newFlag[[]netip.Prefix](name)
+	applyForFlag(addressSetter[[]netip.Prefix]{f}, opts...)
+	if f.Parser() == nil {
+		f.setParser(defaultPrefixSliceParser())
+	}
+	return &PrefixSliceFlag{f}
+}
@@ -0,0 +1,85 @@
+package flag
+
+import (
+	"strings"
+
+	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/parser"
+)
+
+// QuantityValue is the integer number held by Quantity flags, parsed with an optional decimal multiplier
+// suffix: `k` (or `K`), `M`, `G`, `T`, `P` or `E`.
+type QuantityValue int64
+
+var (
+	quantityUnits = []unit{
+		{"E", 1e18}, {"P", 1e15}, {"T", 1e12}, {"G", 1e9}, {"M", 1e6}, {"k", 1e3},
+	}
+	quantitySuffixes = map[string]uint64{
+		"": 1, "k": 1e3, "K": 1e3, "M": 1e6, "G": 1e9, "T": 1e12, "P": 1e15, "E": 1e18,
+	}
+)
+
+// ParseQuantity parses a number with an optional multiplier suffix, such as `64k` or `-1.5M`.
+// The fractional part of the resulting number is truncated.
+func ParseQuantity(input string) (QuantityValue, error) {
+	number, suffix := splitUnit(strings.TrimSpace(input))
+	multiplier, ok := quantitySuffixes[suffix]
+	if !ok {
+		return 0, errors.InvalidValue(input, "unknown multiplier suffix "+suffix)
+	}
+	negative := strings.HasPrefix(number, "-")
+	v, err := scaleNumber(input, strings.TrimPrefix(number, "-"), multiplier)
+	if err != nil {
+		return 0, err
+	}
+	if negative {
+		v.Neg(v)
+	}
+	if !v.IsInt64() {
+		return 0, errors.InvalidValue(input, "quantity overflows 64-bit integer")
+	}
+	return QuantityValue(v.Int64()), nil
+}
+
+// String renders the quantity in a human-readable form, using the largest multiplier
+// suffix not exceeding the absolute value, e.g. `1.5M` or `64k`.
+func (q QuantityValue) String() string {
+	if q < 0 {
+		return "-" + formatUnit(uint64(-(q+1))+1, nil, quantityUnits)
+	}
+	return formatUnit(uint64(q), nil, quantityUnits)
+}
+
+func (q QuantityValue) MarshalText() ([]byte, error) {
+	return []byte(q.String()), nil
+}
+
+func (q *QuantityValue) UnmarshalText(text []byte) error {
+	v, err := ParseQuantity(string(text))
+	if err != nil {
+		return err
+	}
+	*q = v
+	return nil
+}
+
+type quantity = flag[QuantityValue]
+
+type QuantityFlag struct {
+	*quantity
+}
+
+func defaultQuantityParser() *parser.SignedParser[QuantityValue] {
+	return parser.Signed(parser.Func(ParseQuantity))
+}
+
+// Quantity creates a flag holding a QuantityValue.
+func Quantity(name string, opts ...Option) *QuantityFlag {
+	f := newFlag[QuantityValue](name)
+	applyForFlag(f, opts...)
+	if f.Parser() == nil {
+		f.setParser(defaultQuantityParser())
+	}
+	return &QuantityFlag{f}
+}
@@ -0,0 +1,23 @@
+package flag
+
+import "github.com/brongineer/helium/parser"
+
+type quantitySlice = flag[[]QuantityValue]
+
+type QuantitySliceFlag struct {
+	*quantitySlice
+}
+
+func defaultQuantitySliceParser() *parser.SliceParser[QuantityValue] {
+	return parser.Slice(parser.Signed(parser.Func(ParseQuantity)))
+}
+
+// QuantitySlice creates a flag holding a list of QuantityValue values.
+func QuantitySlice(name string, opts ...Option) *QuantitySliceFlag {
+	f := newFlag[[]QuantityValue](name)
+	applyForFlag(f, opts...)
+	if f.Parser() == nil {
+		f.setParser(defaultQuantitySliceParser())
+	}
+	return &QuantitySliceFlag{f}
+}
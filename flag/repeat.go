@@ -0,0 +1,14 @@
+package flag
+
+import "github.com/brongineer/helium/parser"
+
+// RepeatPolicy defines how the flag handles the values of its repeated command-line occurrences.
+type RepeatPolicy = parser.RepeatPolicy
+
+const (
+	RepeatDefault   = parser.RepeatDefault
+	RepeatError     = parser.RepeatError
+	RepeatLastWins  = parser.RepeatLastWins
+	RepeatFirstWins = parser.RepeatFirstWins
+	RepeatAppend    = parser.RepeatAppend
+)
@@ -0,0 +1,108 @@
+package flag
+
+import (
+	"strconv"
+	"time"
+)
+
+func parseString(s string) (string, error) {
+	return s, nil
+}
+
+func parseInt8(s string) (int8, error) {
+	v, err := strconv.ParseInt(s, 10, 8)
+	return int8(v), err
+}
+
+func parseInt16(s string) (int16, error) {
+	v, err := strconv.ParseInt(s, 10, 16)
+	return int16(v), err
+}
+
+func parseInt32(s string) (int32, error) {
+	v, err := strconv.ParseInt(s, 10, 32)
+	return int32(v), err
+}
+
+func parseInt64(s string) (int64, error) {
+	return strconv.ParseInt(s, 10, 64)
+}
+
+func parseUint(s string) (uint, error) {
+	v, err := strconv.ParseUint(s, 10, 32)
+	return uint(v), err
+}
+
+func parseUint8(s string) (uint8, error) {
+	v, err := strconv.ParseUint(s, 10, 8)
+	return uint8(v), err
+}
+
+func parseUint16(s string) (uint16, error) {
+	v, err := strconv.ParseUint(s, 10, 16)
+	return uint16(v), err
+}
+
+func parseUint32(s string) (uint32, error) {
+	v, err := strconv.ParseUint(s, 10, 32)
+	return uint32(v), err
+}
+
+func parseUint64(s string) (uint64, error) {
+	return strconv.ParseUint(s, 10, 64)
+}
+
+func parseFloat32(s string) (float32, error) {
+	v, err := strconv.ParseFloat(s, 32)
+	return float32(v), err
+}
+
+func parseFloat64(s string) (float64, error) {
+	return strconv.ParseFloat(s, 64)
+}
+
+// scalarParseFunc returns the function parsing a single value of type T from its
+// string representation. The second returned value is false if T is not one of
+// the built-in scalar types.
+func scalarParseFunc[T any]() (func(string) (T, error), bool) {
+	var (
+		v  T
+		fn any
+	)
+	switch any(v).(type) {
+	case string:
+		fn = parseString
+	case bool:
+		fn = strconv.ParseBool
+	case int:
+		fn = strconv.Atoi
+	case int8:
+		fn = parseInt8
+	case int16:
+		fn = parseInt16
+	case int32:
+		fn = parseInt32
+	case int64:
+		fn = parseInt64
+	case uint:
+		fn = parseUint
+	case uint8:
+		fn = parseUint8
+	case uint16:
+		fn = parseUint16
+	case uint32:
+		fn = parseUint32
+	case uint64:
+		fn = parseUint64
+	case float32:
+		fn = parseFloat32
+	case float64:
+		fn = parseFloat64
+	case time.Duration:
+		fn = time.ParseDuration
+	default:
+		return nil, false
+	}
+	parseFunc, ok := fn.(func(string) (T, error))
+	return parseFunc, ok
+}
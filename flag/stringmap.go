@@ -0,0 +1,11 @@
+package flag
+
+type stringMap = flag[map[string]string]
+
+type StringMapFlag struct {
+	*stringMap
+}
+
+func StringMap(name string, opts ...Option) *StringMapFlag {
+	return &StringMapFlag{newMapFlag[string, string](name, opts...)}
+}
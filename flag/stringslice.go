@@ -1,9 +1,7 @@
 package flag
 
 import (
-	"strings"
-
-	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/parser"
 )
 
 type stringSlice = flag[[]string]
@@ -12,30 +10,8 @@ type StringSliceFlag struct {
 	*stringSlice
 }
 
-type stringSliceParser struct {
-	*embeddedParser
-}
-
-func defaultStringSliceParser() *stringSliceParser {
-	return &stringSliceParser{&embeddedParser{}}
-}
-
-func (p *stringSliceParser) ParseCmd(input string) (any, error) {
-	var empty string
-	if input == empty {
-		return nil, errors.ErrNoValueProvided
-	}
-	parsed := strings.Split(input, p.Separator())
-	if p.IsSetFromCmd() {
-		stored := DerefOrDie[[]string](p.CurrentValue())
-		parsed = append(stored, parsed...)
-	}
-	return &parsed, nil
-}
-
-func (p *stringSliceParser) ParseEnv(input string) (any, error) {
-	parsed := strings.Split(input, p.Separator())
-	return &parsed, nil
+func defaultStringSliceParser() *parser.SliceParser[string] {
+	return parser.Slice(parser.Func(parseString))
 }
 
 func StringSlice(name string, opts ...Option) *StringSliceFlag {
@@ -0,0 +1,125 @@
+package flag
+
+import (
+	"strings"
+	"time"
+
+	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/parser"
+)
+
+const (
+	relativeNow       = "now"
+	relativeToday     = "today"
+	relativeYesterday = "yesterday"
+	relativeTomorrow  = "tomorrow"
+)
+
+type ftime = flag[time.Time]
+
+type TimeFlag struct {
+	*ftime
+}
+
+type timeParser struct {
+	layouts  []string
+	location *time.Location
+}
+
+// parse accepts either the time in one of the layouts, or a relative expression:
+// `now`, `today`, `yesterday` or `tomorrow`, optionally followed by a signed
+// duration, e.g. `now-2h` or `today+9h30m`.
+func (p timeParser) parse(input string) (time.Time, error) {
+	for _, layout := range p.layouts {
+		if t, err := time.ParseInLocation(layout, input, p.location); err == nil {
+			return t, nil
+		}
+	}
+	if t, ok, err := p.parseRelative(input); ok {
+		return t, err
+	}
+	return time.Time{}, errors.InvalidValue(input, "expected time in one of the layouts: "+strings.Join(p.layouts, ", "))
+}
+
+func (p timeParser) parseRelative(input string) (time.Time, bool, error) {
+	base, offset := input, ""
+	if idx := strings.IndexAny(input, "+-"); idx > -1 {
+		base, offset = input[:idx], input[idx:]
+	}
+	now := time.Now().In(p.location)
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, p.location)
+	var t time.Time
+	switch base {
+	case relativeNow:
+		t = now
+	case relativeToday:
+		t = midnight
+	case relativeYesterday:
+		t = midnight.AddDate(0, 0, -1)
+	case relativeTomorrow:
+		t = midnight.AddDate(0, 0, 1)
+	default:
+		return time.Time{}, false, nil
+	}
+	if offset == "" {
+		return t, true, nil
+	}
+	d, err := time.ParseDuration(offset)
+	if err != nil {
+		return time.Time{}, true, err
+	}
+	return t.Add(d), true, nil
+}
+
+func defaultTimeParser(layouts []string, loc *time.Location) *parser.FuncParser[time.Time] {
+	if len(layouts) == 0 {
+		layouts = []string{time.RFC3339Nano}
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return parser.Func(timeParser{layouts: layouts, location: loc}.parse)
+}
+
+// timeFormatter returns the formatter rendering the time in the layout, so that
+// the value is accepted by the flag with the layout.
+func timeFormatter(layout string, loc *time.Location) func(any) string {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return func(v any) string {
+		return v.(time.Time).In(loc).Format(layout)
+	}
+}
+
+// timeSetter accepts the options specific to time flags along with the common ones.
+type timeSetter struct {
+	*ftime
+	layouts  []string
+	location *time.Location
+}
+
+func (s *timeSetter) setTimeLayouts(layouts []string) {
+	s.layouts = layouts
+}
+
+func (s *timeSetter) setLocation(loc *time.Location) {
+	s.location = loc
+}
+
+// Time creates a flag holding a time.Time. By default, the value is expected in
+// the RFC 3339 format and values without a time zone are interpreted in UTC,
+// see TimeLayouts and Location options to change that. The value is formatted
+// in the first of the layouts.
+func Time(name string, opts ...Option) *TimeFlag {
+	f := newFlag[time.Time](name)
+	s := &timeSetter{ftime: f}
+	applyForFlag(s, opts...)
+	if f.Parser() == nil {
+		f.setParser(defaultTimeParser(s.layouts, s.location))
+	}
+	if f.formatter == nil && len(s.layouts) > 0 {
+		f.setFormatter(timeFormatter(s.layouts[0], s.location))
+	}
+	return &TimeFlag{f}
+}
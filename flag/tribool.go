@@ -0,0 +1,133 @@
+package flag
+
+import (
+	"strconv"
+
+	"github.com/brongineer/helium/errors"
+)
+
+// TriState is the boolean value which distinguishes the value not set explicitly.
+type TriState int8
+
+const (
+	// TriUnset means the value is neither true nor false.
+	TriUnset TriState = iota
+	// TriTrue is the explicit true value.
+	TriTrue
+	// TriFalse is the explicit false value.
+	TriFalse
+)
+
+const unsetText = "unset"
+
+// ParseTriState parses the boolean value in any form accepted by strconv.ParseBool,
+// or "unset" for the TriUnset value.
+func ParseTriState(s string) (TriState, error) {
+	if s == unsetText {
+		return TriUnset, nil
+	}
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return TriUnset, err
+	}
+	return TriStateOf(v), nil
+}
+
+// TriStateOf returns TriTrue or TriFalse for the boolean value.
+func TriStateOf(v bool) TriState {
+	if v {
+		return TriTrue
+	}
+	return TriFalse
+}
+
+// IsSet reports whether the value is either TriTrue or TriFalse.
+func (t TriState) IsSet() bool {
+	return t == TriTrue || t == TriFalse
+}
+
+// Bool returns the boolean value, or the given fallback if the value is TriUnset.
+func (t TriState) Bool(fallback bool) bool {
+	if !t.IsSet() {
+		return fallback
+	}
+	return t == TriTrue
+}
+
+func (t TriState) String() string {
+	switch t {
+	case TriTrue:
+		return "true"
+	case TriFalse:
+		return "false"
+	default:
+		return unsetText
+	}
+}
+
+func (t TriState) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *TriState) UnmarshalText(text []byte) error {
+	v, err := ParseTriState(string(text))
+	if err != nil {
+		return err
+	}
+	*t = v
+	return nil
+}
+
+type ftribool = flag[TriState]
+
+type TriBoolFlag struct {
+	*ftribool
+}
+
+type triBoolParser struct {
+	*embeddedParser
+}
+
+func defaultTriBoolParser() *triBoolParser {
+	return &triBoolParser{&embeddedParser{}}
+}
+
+func (p *triBoolParser) ParseCmd(input string) (any, error) {
+	if p.IsSetFromCmd() {
+		return nil, errors.ErrFlagVisited
+	}
+	if input == "" {
+		parsed := TriTrue
+		return &parsed, nil
+	}
+	return p.ParseEnv(input)
+}
+
+// IsBoolFlag reports that the flag takes no separate command-line argument.
+func (p *triBoolParser) IsBoolFlag() bool {
+	return true
+}
+
+func (p *triBoolParser) ParseEnv(input string) (any, error) {
+	parsed, err := ParseTriState(input)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+// TriBool creates a boolean flag, which is TriUnset unless it is given explicitly or has
+// the default value, so that the value may override the one from other sources in both
+// directions. Like Bool, it may be set to false with the `--no-<name>` form.
+func TriBool(name string, opts ...Option) *TriBoolFlag {
+	f := newFlag[TriState](name)
+	f.negatable = true
+	applyForFlag(f, opts...)
+	if f.defaultValue == nil {
+		f.setDefaultValue(TriUnset)
+	}
+	if f.Parser() == nil {
+		f.setParser(defaultTriBoolParser())
+	}
+	return &TriBoolFlag{f}
+}
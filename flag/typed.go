@@ -1,11 +1,98 @@
 package flag
 
+import (
+	"encoding"
+
+	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/parser"
+)
+
 type TypedFlag[T any] struct {
 	*flag[T]
 }
 
+// stdValue mirrors the Value interface of the standard library flag package.
+type stdValue interface {
+	String() string
+	Set(string) error
+}
+
+// boolValue mirrors the optional interface of the standard library flag package
+// for values which do not require an argument.
+type boolValue interface {
+	IsBoolFlag() bool
+}
+
+func unmarshalText[T any](input string) (T, error) {
+	var v T
+	u, _ := any(&v).(encoding.TextUnmarshaler)
+	err := u.UnmarshalText([]byte(input))
+	return v, err
+}
+
+type stdValueParser[T any] struct {
+	*embeddedParser
+}
+
+func (p *stdValueParser[T]) set(input string, accumulate bool) (any, error) {
+	var v T
+	if current := PtrOrDie[T](p.CurrentValue()); accumulate && current != nil {
+		v = *current
+	}
+	s, _ := any(&v).(stdValue)
+	if err := s.Set(input); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func (p *stdValueParser[T]) ParseCmd(input string) (any, error) {
+	var empty string
+	if input == empty {
+		if !p.IsBoolFlag() {
+			return nil, errors.ErrNoValueProvided
+		}
+		input = "true"
+	}
+	return p.set(input, p.IsSetFromCmd())
+}
+
+// IsBoolFlag reports whether T is the boolean value of the standard library flag package,
+// which takes no separate command-line argument.
+func (p *stdValueParser[T]) IsBoolFlag() bool {
+	b, ok := any(new(T)).(boolValue)
+	return ok && b.IsBoolFlag()
+}
+
+func (p *stdValueParser[T]) ParseEnv(input string) (any, error) {
+	return p.set(input, false)
+}
+
+// defaultTypedParser returns the parser for types implementing either encoding.TextUnmarshaler,
+// or the Value interface of the standard library flag package, with the pointer receiver.
+// For the latter, repeated values are passed to Set of the same value, so that the type may
+// accumulate them. It returns nil if T implements neither of the interfaces.
+func defaultTypedParser[T any]() flagParser {
+	switch any(new(T)).(type) {
+	case encoding.TextUnmarshaler:
+		return parser.Func(unmarshalText[T])
+	case stdValue:
+		return &stdValueParser[T]{&embeddedParser{}}
+	default:
+		return nil
+	}
+}
+
+// Typed creates a flag holding a value of arbitrary type. If no parser is set with the Parser
+// option, the one is picked automatically for types implementing encoding.TextUnmarshaler
+// or the Value interface of the standard library flag package.
 func Typed[T any](name string, opts ...Option) *TypedFlag[T] {
 	f := newFlag[T](name)
 	applyForFlag(f, opts...)
+	if f.Parser() == nil {
+		if p := defaultTypedParser[T](); p != nil {
+			f.setParser(p)
+		}
+	}
 	return &TypedFlag[T]{f}
 }
@@ -1,10 +1,7 @@
 package flag
 
 import (
-	"strconv"
-	"strings"
-
-	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/parser"
 )
 
 type uint32Slice = flag[[]uint32]
@@ -13,46 +10,8 @@ type Uint32SliceFlag struct {
 	*uint32Slice
 }
 
-type uint32SliceParser struct {
-	*embeddedParser
-}
-
-func defaultUint32SliceParser() *uint32SliceParser {
-	return &uint32SliceParser{&embeddedParser{}}
-}
-
-func (p *uint32SliceParser) ParseCmd(input string) (any, error) {
-	var empty string
-	if input == empty {
-		return nil, errors.ErrNoValueProvided
-	}
-	s := strings.Split(input, p.Separator())
-	parsed := make([]uint32, 0, len(s))
-	for _, el := range s {
-		v, err := strconv.ParseUint(el, 10, 32)
-		if err != nil {
-			return nil, err
-		}
-		parsed = append(parsed, uint32(v))
-	}
-	if p.IsSetFromCmd() {
-		stored := DerefOrDie[[]uint32](p.CurrentValue())
-		parsed = append(stored, parsed...)
-	}
-	return &parsed, nil
-}
-
-func (p *uint32SliceParser) ParseEnv(input string) (any, error) {
-	s := strings.Split(input, p.Separator())
-	parsed := make([]uint32, 0, len(s))
-	for _, el := range s {
-		v, err := strconv.ParseUint(el, 10, 32)
-		if err != nil {
-			return nil, err
-		}
-		parsed = append(parsed, uint32(v))
-	}
-	return &parsed, nil
+func defaultUint32SliceParser() *parser.SliceParser[uint32] {
+	return parser.Slice(parser.Func(parseUint32))
 }
 
 func Uint32Slice(name string, opts ...Option) *Uint32SliceFlag {
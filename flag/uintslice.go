@@ -1,10 +1,7 @@
 package flag
 
 import (
-	"strconv"
-	"strings"
-
-	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/parser"
 )
 
 type uintSlice = flag[[]uint]
@@ -13,46 +10,8 @@ type UintSliceFlag struct {
 	*uintSlice
 }
 
-type uintSliceParser struct {
-	*embeddedParser
-}
-
-func defaultUintSliceParser() *uintSliceParser {
-	return &uintSliceParser{&embeddedParser{}}
-}
-
-func (p *uintSliceParser) ParseCmd(input string) (any, error) {
-	var empty string
-	if input == empty {
-		return nil, errors.ErrNoValueProvided
-	}
-	s := strings.Split(input, p.Separator())
-	parsed := make([]uint, 0, len(s))
-	for _, el := range s {
-		v, err := strconv.ParseUint(el, 10, 32)
-		if err != nil {
-			return nil, err
-		}
-		parsed = append(parsed, uint(v))
-	}
-	if p.IsSetFromCmd() {
-		stored := DerefOrDie[[]uint](p.CurrentValue())
-		parsed = append(stored, parsed...)
-	}
-	return &parsed, nil
-}
-
-func (p *uintSliceParser) ParseEnv(input string) (any, error) {
-	s := strings.Split(input, p.Separator())
-	parsed := make([]uint, 0, len(s))
-	for _, el := range s {
-		v, err := strconv.ParseUint(el, 10, 32)
-		if err != nil {
-			return nil, err
-		}
-		parsed = append(parsed, uint(v))
-	}
-	return &parsed, nil
+func defaultUintSliceParser() *parser.SliceParser[uint] {
+	return parser.Slice(parser.Func(parseUint))
 }
 
 func UintSlice(name string, opts ...Option) *UintSliceFlag {
@@ -0,0 +1,69 @@
+package flag
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/brongineer/helium/errors"
+)
+
+type unit struct {
+	suffix     string
+	multiplier uint64
+}
+
+// splitUnit splits the input into its numeric part and the unit suffix.
+func splitUnit(input string) (string, string) {
+	idx := strings.LastIndexFunc(input, func(r rune) bool {
+		return (r >= '0' && r <= '9') || r == '.'
+	})
+	return strings.TrimSpace(input[:idx+1]), strings.TrimSpace(input[idx+1:])
+}
+
+// scaleNumber multiplies the decimal number by the multiplier. The fractional
+// part of the result is truncated. The number has to be non-negative.
+func scaleNumber(input, number string, multiplier uint64) (*big.Int, error) {
+	r, ok := new(big.Rat).SetString(number)
+	if !ok || number == "" || strings.ContainsAny(number, "eE/") {
+		return nil, errors.InvalidValue(input, "not a number")
+	}
+	if r.Sign() < 0 {
+		return nil, errors.InvalidValue(input, "value must not be negative")
+	}
+	r.Mul(r, new(big.Rat).SetUint64(multiplier))
+	return new(big.Int).Quo(r.Num(), r.Denom()), nil
+}
+
+// formatUnit renders the value using the largest of the exact units it is a whole
+// multiple of, or, if there is no such unit, using the largest of the approximate
+// units not exceeding the value with a fractional number. Both lists are expected
+// to be sorted in descending order, and the multipliers of the approximate units
+// are expected to be powers of ten, so that the fractional number is rendered exactly
+// and is parsed back to the same value.
+func formatUnit(v uint64, exact, approximate []unit) string {
+	for _, u := range exact {
+		if v >= u.multiplier && v%u.multiplier == 0 {
+			return strconv.FormatUint(v/u.multiplier, 10) + u.suffix
+		}
+	}
+	for _, u := range approximate {
+		if v >= u.multiplier {
+			return formatDecimal(v, u.multiplier) + u.suffix
+		}
+	}
+	return strconv.FormatUint(v, 10)
+}
+
+// formatDecimal renders v divided by the power of ten as the exact decimal number, e.g. `1.5` for 1500 and 1000.
+func formatDecimal(v, powerOfTen uint64) string {
+	whole := strconv.FormatUint(v/powerOfTen, 10)
+	rem := v % powerOfTen
+	if rem == 0 {
+		return whole
+	}
+	digits := len(strconv.FormatUint(powerOfTen, 10)) - 1
+	frac := strconv.FormatUint(rem, 10)
+	frac = strings.Repeat("0", digits-len(frac)) + frac
+	return whole + "." + strings.TrimRight(frac, "0")
+}
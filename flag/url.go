@@ -0,0 +1,68 @@
+package flag
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/brongineer/helium/errors"
+	"github.com/brongineer/helium/parser"
+)
+
+type furl = flag[url.URL]
+
+type URLFlag struct {
+	*furl
+}
+
+// parseURL parses an absolute URL, i.e. the one having a scheme.
+func parseURL(input string) (url.URL, error) {
+	u, err := url.Parse(input)
+	if err != nil {
+		return url.URL{}, err
+	}
+	if u.Scheme == "" {
+		return url.URL{}, errors.InvalidValue(input, "absolute URL required")
+	}
+	return *u, nil
+}
+
+func urlSchemeValidator(schemes []string) func(any) error {
+	validate := func(u url.URL) error {
+		if !slices.ContainsFunc(schemes, func(s string) bool { return strings.EqualFold(s, u.Scheme) }) {
+			return errors.InvalidValue(u.String(), "scheme must be one of: "+strings.Join(schemes, ", "))
+		}
+		return nil
+	}
+	return func(v any) error {
+		switch val := v.(type) {
+		case *url.URL:
+			return validate(*val)
+		case *[]url.URL:
+			return validateEach(*val, validate)
+		}
+		return nil
+	}
+}
+
+// urlSetter accepts the options specific to URL flags along with the common ones.
+type urlSetter[T any] struct {
+	*flag[T]
+}
+
+func (s urlSetter[T]) addURLValidator(v func(any) error) {
+	s.addValidator(v)
+}
+
+func defaultURLParser() *parser.FuncParser[url.URL] {
+	return parser.Func(parseURL)
+}
+
+func URL(name string, opts ...Option) *URLFlag {
+	f := newFlag[url.URL](name)
+	applyForFlag(urlSetter[url.URL]{f}, opts...)
+	if f.Parser() == nil {
+		f.setParser(defaultURLParser())
+	}
+	return &URLFlag{f}
+}
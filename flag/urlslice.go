@@ -0,0 +1,26 @@
+package flag
+
+import (
+	"net/url"
+
+	"github.com/brongineer/helium/parser"
+)
+
+type urlSlice = flag[[]url.URL]
+
+type URLSliceFlag struct {
+	*urlSlice
+}
+
+func defaultURLSliceParser() *parser.SliceParser[url.URL] {
+	return parser.Slice(parser.Func(parseURL))
+}
+
+func URLSlice(name string, opts ...Option) *URLSliceFlag {
+	f := newFlag[[]url.URL](name)
+	applyForFlag(urlSetter[[]url.URL]{f}, opts...)
+	if f.Parser() == nil {
+		f.setParser(defaultURLSliceParser())
+	}
+	return &URLSliceFlag{f}
+}
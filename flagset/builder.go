@@ -1,7 +1,12 @@
 package flagset
 
 import (
+	"fmt"
+	"io"
+	"os"
+
 	"github.com/brongineer/helium/env"
+	"github.com/brongineer/helium/flag"
 )
 
 type Builder struct {
@@ -10,11 +15,70 @@ type Builder struct {
 
 func New(opts ...env.Option) *Builder {
 	envConstructor := env.Constructor(opts...)
-	return &Builder{
-		fs: &FlagSet{envVarBinder: envConstructor},
+	fs := &FlagSet{envVarBinder: envConstructor}
+	fs.deprecation = fs.deprecationWarning(os.Stderr)
+	return &Builder{fs: fs}
+}
+
+// deprecationWarning returns the deprecation callback writing the warning to w.
+// Flag names are prefixed the way they are typed in the parse mode of the FlagSet.
+func (fs *FlagSet) deprecationWarning(w io.Writer) func(alias, name string) {
+	return func(alias, name string) {
+		prefix := fs.longFlagPrefix()
+		_, _ = fmt.Fprintf(w, "Warning: flag %s%s is deprecated, use %s%s instead\n", prefix, alias, prefix, name)
 	}
 }
 
+// DeprecationWriter sets the writer for warnings about the use of the deprecated flag aliases.
+// Warnings are written to stderr by default.
+func (b *Builder) DeprecationWriter(w io.Writer) *Builder {
+	b.fs.deprecation = b.fs.deprecationWarning(w)
+	return b
+}
+
+// OnDeprecated sets the function called instead of writing the warning, if the flag is given
+// with the deprecated alias. It receives the alias and the name of the flag.
+func (b *Builder) OnDeprecated(fn func(alias, name string)) *Builder {
+	b.fs.deprecation = fn
+	return b
+}
+
+// IgnoreCase makes the FlagSet match long flag names case-insensitively, so that
+// e.g. `--Verbose` is the same as `--verbose`. Shorthands are always case-sensitive.
+// It has to be called before the flags are bound, so that names differing only
+// in case are rejected.
+func (b *Builder) IgnoreCase() *Builder {
+	b.fs.ignoreCase = true
+	return b
+}
+
+// DisableAbbreviations makes the FlagSet accept only complete long flag names.
+// By default, a long flag name may be abbreviated to any prefix unique among the flag names,
+// e.g. `--verb` is the same as `--verbose`, if no other flag name starts with `verb`.
+func (b *Builder) DisableAbbreviations() *Builder {
+	b.fs.noAbbrev = true
+	return b
+}
+
+// RepeatPolicy sets the policy for repeated command-line values of the flags
+// which do not define their own policy with the flag.Repeat option.
+func (b *Builder) RepeatPolicy(policy flag.RepeatPolicy) *Builder {
+	b.fs.repeatPolicy = policy
+	return b
+}
+
+// ResponseFiles makes the FlagSet expand every `@path` argument into the arguments read
+// from the file at the path before parsing. Arguments in the file are split following
+// the shell quoting rules and may refer to other response files, up to 10 levels deep.
+// Relative paths are resolved against the working directory. An argument starting with
+// `@@` is passed with the first at-sign removed, e.g. `@@user` is parsed as `@user`.
+// The value argument of a flag with the flag.ValueFromFile option is not expanded,
+// the flag reads the file itself.
+func (b *Builder) ResponseFiles() *Builder {
+	b.fs.responseFile = true
+	return b
+}
+
 func (b *Builder) BindFlag(f flagItem) *Builder {
 	b.fs.addFlag(f)
 	return b
@@ -23,3 +87,9 @@ func (b *Builder) BindFlag(f flagItem) *Builder {
 func (b *Builder) Build() *FlagSet {
 	return b.fs
 }
+
+// ParseMode sets the syntax of the command-line arguments accepted by the FlagSet.
+func (b *Builder) ParseMode(mode ParseMode) *Builder {
+	b.fs.mode = mode
+	return b
+}
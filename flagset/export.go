@@ -0,0 +1,274 @@
+package flagset
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/brongineer/helium/flag"
+)
+
+// ExportFormat defines the format of the configuration written by FlagSet.Export.
+type ExportFormat int
+
+const (
+	// ExportJSON writes the JSON object mapping the flag names to their values.
+	ExportJSON ExportFormat = iota
+	// ExportYAML writes the YAML mapping of the flag names to their values.
+	ExportYAML
+	// ExportEnv writes the `.env` file assigning the values to the environment variables
+	// bound to the flags. Values are quoted following the dotenv rules.
+	ExportEnv
+	// ExportCommandLine writes the command-line arguments setting the flags to their values.
+	ExportCommandLine
+)
+
+const jsonIndent = "  "
+
+type exportConfig struct {
+	nonDefaultOnly bool
+}
+
+// ExportOption configures FlagSet.Export.
+type ExportOption interface {
+	apply(*exportConfig)
+}
+
+type nonDefaultOnly struct{}
+
+func (o nonDefaultOnly) apply(c *exportConfig) {
+	c.nonDefaultOnly = true
+}
+
+// NonDefaultOnly makes FlagSet.Export write only the flags set from the command line
+// or the environment variables.
+func NonDefaultOnly() ExportOption {
+	return nonDefaultOnly{}
+}
+
+// Export writes the effective values of the flags of the FlagSet to w in the given format,
+// in the order the flags were bound. Values of secret flags are redacted, and the values
+// read from files are written as `@path`. Flags without a value are written as null
+// in JSON and YAML, and are omitted from the `.env` file and the command line.
+func (fs *FlagSet) Export(w io.Writer, format ExportFormat, opts ...ExportOption) error {
+	var c exportConfig
+	for _, opt := range opts {
+		opt.apply(&c)
+	}
+	flags := make([]flagItem, 0, len(fs.flags))
+	for _, f := range fs.flags {
+		if !c.nonDefaultOnly || f.IsSetFromCmd() || f.IsSetFromEnv() {
+			flags = append(flags, f)
+		}
+	}
+	var (
+		out []byte
+		err error
+	)
+	switch format {
+	case ExportJSON:
+		out, err = exportJSON(flags)
+	case ExportYAML:
+		out, err = exportYAML(flags)
+	case ExportEnv:
+		out = fs.exportEnv(flags)
+	case ExportCommandLine:
+		out = exportCommandLine(flags)
+	default:
+		return fmt.Errorf("unknown export format: %d", format)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(out)
+	return err
+}
+
+func exportJSON(flags []flagItem) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("{")
+	for i, f := range flags {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		name, err := json.Marshal(f.Name())
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(exportValue(f))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.Name(), err)
+		}
+		buf.Write(name)
+		buf.WriteString(":")
+		buf.Write(value)
+	}
+	buf.WriteString("}")
+	var out bytes.Buffer
+	if err := json.Indent(&out, buf.Bytes(), "", jsonIndent); err != nil {
+		return nil, err
+	}
+	out.WriteString("\n")
+	return out.Bytes(), nil
+}
+
+// exportYAML writes the names and the values in the JSON syntax, which is the flow style of YAML.
+// Names are always quoted, so that e.g. `on` or `null` are not taken for booleans or nulls.
+func exportYAML(flags []flagItem) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, f := range flags {
+		value, err := json.Marshal(exportValue(f))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.Name(), err)
+		}
+		name, err := json.Marshal(f.Name())
+		if err != nil {
+			return nil, err
+		}
+		_, _ = fmt.Fprintf(&buf, "%s: %s\n", name, value)
+	}
+	return buf.Bytes(), nil
+}
+
+func (fs *FlagSet) exportEnv(flags []flagItem) []byte {
+	var buf bytes.Buffer
+	for _, f := range flags {
+		if !hasValue(f) {
+			continue
+		}
+		name := fs.envVarBinder.VarFromFlagName(f.Name())
+		_, _ = fmt.Fprintf(&buf, "%s=%s\n", name, envQuote(f.String()))
+	}
+	return buf.Bytes()
+}
+
+// exportCommandLine writes the arguments returned by Arguments of the flags,
+// with the values of the secret flags redacted.
+func exportCommandLine(flags []flagItem) []byte {
+	args := make([]string, 0, len(flags))
+	for _, f := range flags {
+		for _, arg := range f.Arguments() {
+			if f.IsSecret() {
+				name, _, _ := strings.Cut(arg, inlineValueSeparator)
+				arg = name + inlineValueSeparator + f.String()
+			}
+			args = append(args, shellQuote(arg))
+		}
+	}
+	return []byte(strings.Join(args, " ") + "\n")
+}
+
+// exportValue returns the value of the flag to be encoded to JSON. Booleans, numbers and strings
+// are encoded by themselves, and slices and maps are encoded element by element. Other values,
+// as well as the elements and map keys of types having String or MarshalText methods, are encoded
+// as strings formatted the same way as by String of the flag. Secret values and the ones read
+// from files are encoded as they are returned by String.
+func exportValue(f flagItem) any {
+	if !hasValue(f) {
+		return nil
+	}
+	if f.IsSecret() || f.ValueFile() != "" {
+		return f.String()
+	}
+	rv := reflect.Indirect(reflect.ValueOf(f.Value()))
+	switch {
+	case isPlain(rv.Type()):
+		return rv.Interface()
+	case rv.Kind() == reflect.Slice && !hasTextMethods(rv.Type()):
+		elems := make([]any, 0, rv.Len())
+		for i := range rv.Len() {
+			elems = append(elems, jsonElem(rv.Index(i)))
+		}
+		return elems
+	case rv.Kind() == reflect.Map && !hasTextMethods(rv.Type()):
+		m := make(map[string]any, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			m[flag.FormatElem(iter.Key(), "")] = jsonElem(iter.Value())
+		}
+		return m
+	default:
+	}
+	return f.String()
+}
+
+// jsonElem returns the element of the slice or map value to be encoded to JSON.
+func jsonElem(v reflect.Value) any {
+	if isPlain(v.Type()) {
+		return v.Interface()
+	}
+	return flag.FormatElem(v, "")
+}
+
+var (
+	stringerType      = reflect.TypeFor[fmt.Stringer]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// hasTextMethods reports whether the type, or the pointer to it, has String or MarshalText method.
+func hasTextMethods(t reflect.Type) bool {
+	p := reflect.PointerTo(t)
+	return p.Implements(stringerType) || p.Implements(textMarshalerType)
+}
+
+// isPlain reports whether the type is a boolean, a number or a string without String
+// or MarshalText methods, which is encoded to JSON by itself.
+func isPlain(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool, reflect.String, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return !hasTextMethods(t)
+	default:
+		return false
+	}
+}
+
+// hasValue reports whether the flag has either the value or the default one.
+func hasValue(f flagItem) bool {
+	v := reflect.ValueOf(f.Value())
+	return v.IsValid() && (v.Kind() != reflect.Pointer || !v.IsNil())
+}
+
+// envQuote returns the value quoted for the `.env` file, unless it consists of safe characters only.
+// The value is single-quoted, which keeps it literal, unless it contains a single quote or a line break.
+// Such values are double-quoted with the backslash, the double quote and the dollar sign escaped
+// with a backslash, and line breaks written as `\n` and `\r`. Other characters, including non-ASCII
+// ones, are written as they are.
+func envQuote(value string) string {
+	switch {
+	case isShellSafe(value):
+		return value
+	case !strings.ContainsAny(value, "'\n\r"):
+		return "'" + value + "'"
+	default:
+		return `"` + envEscaper.Replace(value) + `"`
+	}
+}
+
+var envEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`, "\n", `\n`, "\r", `\r`)
+
+// shellQuote returns the argument single-quoted for the POSIX shell, unless it consists of safe characters only.
+func shellQuote(arg string) string {
+	if isShellSafe(arg) {
+		return arg
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
+
+func isShellSafe(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !strings.ContainsRune("-_./:@,+=%", r) &&
+			(r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -3,6 +3,8 @@ package flagset
 import (
 	"errors"
 	"fmt"
+	"net/netip"
+	"net/url"
 	"os"
 	"slices"
 	"strings"
@@ -11,44 +13,96 @@ import (
 	"github.com/brongineer/helium/env"
 	ferrors "github.com/brongineer/helium/errors"
 	"github.com/brongineer/helium/flag"
+	"github.com/brongineer/helium/parser"
 )
 
 const (
-	longFlagNamePrefix  = "--"
-	shortFlagNamePrefix = "-"
+	longFlagNamePrefix   = "--"
+	shortFlagNamePrefix  = "-"
+	inlineValueSeparator = "="
+	negationPrefix       = "no-"
 )
 
-type flagItem interface {
+// Flag is the flag which may be bound to the FlagSet.
+// It is implemented by all flags of the flag package.
+type Flag interface {
 	Value() any
 	Name() string
 	Description() string
 	Shorthand() string
 	Separator() string
+	Choices() []string
 	IsShared() bool
+	ConsumesNextArg() bool
+	IsBoolFlag() bool
+	AcceptsLeadingDash() bool
+	IsNegatable() bool
+	NoArgValue() (string, bool)
+	Aliases() []string
+	DeprecatedAliases() []string
+	IsHidden() bool
+	ValueFile() string
+	IsValueFromFile() bool
+	IsSecret() bool
+	Arguments() []string
+	DefaultString() string
+	Category() string
 	IsSetFromEnv() bool
 	IsSetFromCmd() bool
 	FromCommandLine(string) error
 	FromEnvVariable(string) error
+	ParseContext(parser.Context, string) error
+	String() string
 }
 
+type flagItem = Flag
+
+// ParseMode defines the syntax of the command-line arguments.
+type ParseMode int
+
+const (
+	// ParseModeDefault accepts long flag names prefixed with `--` and shorthands prefixed with `-`,
+	// which may be stacked, e.g. `-abc` is the same as `-a -b -c`.
+	ParseModeDefault ParseMode = iota
+	// ParseModeGo follows the standard library flag package: long flag names may be prefixed
+	// with either `-` or `--`, and shorthands may not be stacked. A single-dash name which
+	// does not match any long flag name is looked up among shorthands.
+	ParseModeGo
+)
+
 type FlagSet struct {
 	flags        []flagItem
 	envVarBinder *env.VarNameConstructor
+	mode         ParseMode
+	ignoreCase   bool
+	noAbbrev     bool
+	repeatPolicy flag.RepeatPolicy
+	deprecation  func(alias, name string)
+	responseFile bool
 }
 
 // Parse iterates over the given args and calls the corresponding parse function
 // for long flags and short flags. It returns an error if any parsing fails.
+// If response files are enabled, the `@path` arguments are expanded first.
 func (fs *FlagSet) Parse(args []string) error {
 	var (
 		i   int
 		err error
 	)
 
+	if fs.responseFile {
+		if args, err = fs.expandResponseFiles(args, 0); err != nil {
+			return err
+		}
+	}
+
 	for i = 0; i < len(args); {
 		switch {
 		case strings.HasPrefix(args[i], longFlagNamePrefix):
 			i, err = fs.parseLong(args, i)
-		case strings.HasPrefix(args[i], shortFlagNamePrefix):
+		case isShortFlag(args[i]) && fs.mode == ParseModeGo:
+			i, err = fs.parseSingleDash(args, i)
+		case isShortFlag(args[i]):
 			i, err = fs.parseShort(args, i)
 		default:
 			i++
@@ -60,24 +114,52 @@ func (fs *FlagSet) Parse(args []string) error {
 	return nil
 }
 
+// Lookup returns the flag with the given name, or nil if there is no such flag.
+func (fs *FlagSet) Lookup(name string) Flag {
+	return fs.flagByExactName(name)
+}
+
+// VisitAll calls fn for each flag of the FlagSet in the order they were bound.
+func (fs *FlagSet) VisitAll(fn func(Flag)) {
+	for _, f := range fs.flags {
+		fn(f)
+	}
+}
+
+// Arguments returns the command-line arguments reproducing the current values of the flags
+// of the FlagSet, which were set from the command line or from the environment variables,
+// in the order the flags were bound. Parsing them with a FlagSet having the same flags
+// results in the same values. Values of the secret flags are not redacted.
+func (fs *FlagSet) Arguments() []string {
+	var args []string
+	for _, f := range fs.flags {
+		if f.IsSetFromCmd() || f.IsSetFromEnv() {
+			args = append(args, f.Arguments()...)
+		}
+	}
+	return args
+}
+
 // BindEnvVars binds environment variables to the corresponding flags in the FlagSet.
 // It constructs a VarNameConstructor using the provided characters 'charOld' and 'charNew'
 // and the environment options in the FlagSet. For each flag in the FlagSet, it retrieves
-// the value of the environment variable using the VarNameConstructor and calls the
-// FromEnvVariable method of the flag. If an error occurs during parsing, it is joined
-// with the previous errors using the errors.Join function. The function returns the
-// error encountered during parsing, if any.
+// the value of the environment variable using the VarNameConstructor and parses it
+// with the flag, passing the variable name in the parse context. If an error occurs
+// during parsing, it is joined with the previous errors using the errors.Join function.
+// The function returns the error encountered during parsing, if any.
 func (fs *FlagSet) BindEnvVars() error {
 	var (
 		empty string
 		err   error
 	)
 	for _, f := range fs.flags {
-		val := os.Getenv(fs.envVarBinder.VarFromFlagName(f.Name()))
+		name := fs.envVarBinder.VarFromFlagName(f.Name())
+		val := os.Getenv(name)
 		if val == empty {
 			continue
 		}
-		if e := f.FromEnvVariable(val); e != nil {
+		ctx := parser.Context{Source: parser.SourceEnvironment, EnvVar: name, ArgIndex: -1, Lookup: fs.lookup}
+		if e := f.ParseContext(ctx, val); e != nil {
 			err = errors.Join(e)
 		}
 	}
@@ -85,96 +167,271 @@ func (fs *FlagSet) BindEnvVars() error {
 }
 
 // parseLong trims the long flag name prefix from the argument and checks if
-// the flag exists in the FlagSet. If the flag exists, it delegates to the parse
-// method to parse the flag value. Returns the index of the next flag and any error
-// encountered during parsing.
+// the flag exists in the FlagSet. If the flag exists, it parses the value given inline
+// after `=`, or delegates to the parse method to parse the flag value from the following
+// arguments. Returns the index of the next flag and any error encountered during parsing.
 func (fs *FlagSet) parseLong(args []string, i int) (int, error) {
 	trimmed := strings.TrimPrefix(args[i], longFlagNamePrefix)
-	f := fs.flagByName(trimmed)
+	name, value, inline := strings.Cut(trimmed, inlineValueSeparator)
+	f := fs.flagByName(name)
 	if f == nil {
-		return -1, ferrors.UnknownFlag(trimmed)
+		return fs.parseNegated(name, inline, i)
+	}
+	fs.warnIfDeprecated(f, name)
+	if inline {
+		return i + 1, f.ParseContext(fs.cmdContext(i), value)
 	}
 	return fs.parse(f, i, args)
 }
 
 // parseShort trims the short flag name prefix from the argument and checks if
-// the flag exists in the FlagSet. If the flag exists, it delegates to the parse
-// method to parse the flag value. Returns the index of the next flag and any error
-// encountered during parsing.
+// the flag exists in the FlagSet. If the flag exists, it parses the value given inline
+// after `=`, or delegates to the parse method to parse the flag value from the following
+// arguments. Returns the index of the next flag and any error encountered during parsing.
 func (fs *FlagSet) parseShort(args []string, i int) (int, error) {
 	trimmed := strings.TrimPrefix(args[i], shortFlagNamePrefix)
+	trimmed, value, inline := strings.Cut(trimmed, inlineValueSeparator)
 	if len(trimmed) > 1 {
 		stacked := strings.Split(trimmed, "")
-		if err := fs.parseStacked(stacked[:len(stacked)-1]); err != nil {
+		if err := fs.parseStacked(stacked[:len(stacked)-1], i); err != nil {
 			return -1, err
 		}
 		trimmed = stacked[len(stacked)-1]
 	}
 	f := fs.flagByShorthand(trimmed)
 	if f == nil {
-		return -1, ferrors.UnknownShorthand(trimmed)
+		return -1, ferrors.UnknownShorthand(trimmed, fs.suggestShorthands(trimmed)...)
+	}
+	if inline {
+		return i + 1, f.ParseContext(fs.cmdContext(i), value)
+	}
+	return fs.parse(f, i, args)
+}
+
+// parseSingleDash trims the short flag name prefix from the argument and looks up the flag
+// by its exact long name, by its shorthand, and then by the abbreviated long name, without
+// splitting the name into stacked shorthands. If the flag exists, it parses the value given
+// inline after `=`, or delegates to the parse method to parse the flag value from the following
+// arguments. Returns the index of the next flag and any error encountered during parsing.
+func (fs *FlagSet) parseSingleDash(args []string, i int) (int, error) {
+	trimmed := strings.TrimPrefix(args[i], shortFlagNamePrefix)
+	name, value, inline := strings.Cut(trimmed, inlineValueSeparator)
+	f := fs.flagBySingleDashName(name)
+	if f == nil {
+		return fs.parseNegated(name, inline, i)
+	}
+	fs.warnIfDeprecated(f, name)
+	if inline {
+		return i + 1, f.ParseContext(fs.cmdContext(i), value)
 	}
 	return fs.parse(f, i, args)
 }
 
+// flagBySingleDashName searches for the flag given with the single-dash name in ParseModeGo:
+// by its exact long name, by its shorthand, and then by the abbreviated long name.
+// It returns the found flagItem or nil if no match is found.
+func (fs *FlagSet) flagBySingleDashName(name string) flagItem {
+	f := fs.flagByExactName(name)
+	if f == nil && name != "" {
+		f = fs.flagByShorthand(name)
+	}
+	if f == nil {
+		f = fs.flagByName(name)
+	}
+	return f
+}
+
+// parseNegated sets the negatable flag to false, if the name is the flag name prefixed with `no-`,
+// e.g. `--no-verbose` for `--verbose`. The negated form takes no value. Returns the index of the
+// next argument, or the error if there is no such flag.
+func (fs *FlagSet) parseNegated(name string, inline bool, i int) (int, error) {
+	trimmed, negated := strings.CutPrefix(name, negationPrefix)
+	var f flagItem
+	if negated {
+		f = fs.flagByName(trimmed)
+	}
+	if f == nil || !f.IsNegatable() {
+		return -1, fs.unknownFlag(name)
+	}
+	fs.warnIfDeprecated(f, trimmed)
+	if inline {
+		return -1, ferrors.ParseError(f.Name(), ferrors.InvalidValue(name, "negated flag takes no value"))
+	}
+	return i + 1, f.ParseContext(fs.cmdContext(i), "false")
+}
+
+// longFlagPrefix returns the prefix of the long flag names shown in messages:
+// a single dash in ParseModeGo, where it is the prefix of the standard library.
+func (fs *FlagSet) longFlagPrefix() string {
+	if fs.mode == ParseModeGo {
+		return shortFlagNamePrefix
+	}
+	return longFlagNamePrefix
+}
+
+// warnIfDeprecated reports the use of the deprecated alias, if the flag was given with one.
+func (fs *FlagSet) warnIfDeprecated(f flagItem, name string) {
+	if !slices.ContainsFunc(f.DeprecatedAliases(), func(alias string) bool { return fs.namesEqual(alias, name) }) {
+		return
+	}
+	if fs.deprecation != nil {
+		fs.deprecation(name, f.Name())
+	}
+}
+
 // parse iterates over the given args and calls the corresponding parse function
-// for long flags and short flags. It returns the index of the next flag and any error
+// for long flags and short flags. Flags with the no-argument value are parsed from it
+// without taking the following args. In ParseModeGo, the value is taken the way
+// the standard library does. It returns the index of the next flag and any error
 // encountered during parsing.
 func (fs *FlagSet) parse(f flagItem, i int, args []string) (int, error) {
-	idx := nextFlagIndex(i+1, args)
+	ctx := fs.cmdContext(i)
+	if v, ok := f.NoArgValue(); ok {
+		return i + 1, f.ParseContext(ctx, v)
+	}
+	if fs.mode == ParseModeGo {
+		return fs.parseNextArg(f, i, args)
+	}
+	if f.ConsumesNextArg() && i+1 < len(args) {
+		return i + 2, f.ParseContext(ctx, args[i+1])
+	}
+	idx := fs.nextFlagIndex(f, i+1, args)
 	if idx > -1 {
 		v := strings.Join(args[i+1:idx], f.Separator())
-		if err := f.FromCommandLine(v); err != nil {
+		if err := f.ParseContext(ctx, v); err != nil {
 			return -1, err
 		}
 		return idx, nil
 	}
 	if i == len(args)-1 {
-		if err := f.FromCommandLine(""); err != nil {
+		if err := f.ParseContext(ctx, ""); err != nil {
 			return -1, err
 		}
 		return len(args), nil
 	}
 	v := strings.Join(args[i+1:], f.Separator())
-	return len(args), f.FromCommandLine(v)
+	return len(args), f.ParseContext(ctx, v)
+}
+
+// parseNextArg parses the flag from exactly one argument following it, even if the argument
+// starts with a dash, like the standard library flag package does. Boolean flags take
+// no argument and are parsed from an empty input. Returns the index of the next argument
+// and any error encountered during parsing.
+func (fs *FlagSet) parseNextArg(f flagItem, i int, args []string) (int, error) {
+	ctx := fs.cmdContext(i)
+	if f.IsBoolFlag() || i+1 == len(args) {
+		if err := f.ParseContext(ctx, ""); err != nil {
+			return -1, err
+		}
+		return i + 1, nil
+	}
+	if err := f.ParseContext(ctx, args[i+1]); err != nil {
+		return -1, err
+	}
+	return i + 2, nil
 }
 
 // parseStacked iterates over the given stacked flags and checks if each flag exists in the FlagSet.
-// If the flag exists, it calls the FromCommandLine method of the flag with an empty value.
-// Returns an error if any flag is not found or if an error occurs during parsing.
-func (fs *FlagSet) parseStacked(stacked []string) error {
+// If the flag exists, it parses the flag with its no-argument value, or with an empty value.
+// The index i is the index of the argument holding the stacked flags. Returns an error
+// if any flag is not found or if an error occurs during parsing.
+func (fs *FlagSet) parseStacked(stacked []string, i int) error {
 	for _, s := range stacked {
 		f := fs.flagByShorthand(s)
 		if f == nil {
-			return ferrors.UnknownShorthand(s)
+			return ferrors.UnknownShorthand(s, fs.suggestShorthands(s)...)
 		}
-		if err := f.FromCommandLine(""); err != nil {
+		v, _ := f.NoArgValue()
+		if err := f.ParseContext(fs.cmdContext(i), v); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// cmdContext returns the parse context for the flag found at the index i of the command-line arguments.
+func (fs *FlagSet) cmdContext(i int) parser.Context {
+	return parser.Context{
+		Source:       parser.SourceCommandLine,
+		ArgIndex:     i,
+		RepeatPolicy: fs.repeatPolicy,
+		Lookup:       fs.lookup,
+	}
+}
+
+// lookup returns the pointer to the value of the flag with the given name.
+// The second returned value is false if the flag does not exist.
+func (fs *FlagSet) lookup(name string) (any, bool) {
+	f := fs.flagByExactName(name)
+	if f == nil {
+		return nil, false
+	}
+	return f.Value(), true
+}
+
 // nextFlagIndex finds the index of the next flag name in the given arguments
-// starting from the specified index. It returns the index of the next flag name
-// if found, otherwise it returns -1.
-func nextFlagIndex(start int, args []string) int {
+// starting from the specified index. Negative values are taken as values of the flag f
+// accepting them rather than flag names, unless there is a flag with a digit shorthand.
+// It returns the index of the next flag name if found, otherwise it returns -1.
+func (fs *FlagSet) nextFlagIndex(f flagItem, start int, args []string) int {
+	negativeValues := f.AcceptsLeadingDash() && !fs.hasDigitShorthand()
 	for i := start; i < len(args); i++ {
+		if negativeValues && isNegativeValue(args[i]) {
+			continue
+		}
 		if strings.HasPrefix(args[i], longFlagNamePrefix) {
 			return i
 		}
-		if strings.HasPrefix(args[i], shortFlagNamePrefix) {
+		if isShortFlag(args[i]) {
 			return i
 		}
 	}
 	return -1
 }
 
+// isShortFlag reports whether the argument is a short flag. A lone dash is not a flag,
+// it is the conventional value standing for stdin.
+func isShortFlag(arg string) bool {
+	return strings.HasPrefix(arg, shortFlagNamePrefix) && arg != shortFlagNamePrefix
+}
+
+// hasDigitShorthand reports whether any flag of the FlagSet has a digit as the shorthand.
+func (fs *FlagSet) hasDigitShorthand() bool {
+	return slices.ContainsFunc(fs.flags, func(f flagItem) bool {
+		s := f.Shorthand()
+		return len(s) == 1 && s[0] >= '0' && s[0] <= '9'
+	})
+}
+
+// isNegativeValue reports whether the argument is a dash followed by a digit, optionally
+// after the decimal point, e.g. `-5`, `-.5`, `-1.5M` or `-5s`. Other forms accepted by
+// strconv.ParseFloat, such as `-inf`, are not values here.
+func isNegativeValue(arg string) bool {
+	rest, ok := strings.CutPrefix(arg, shortFlagNamePrefix)
+	rest = strings.TrimPrefix(rest, ".")
+	return ok && rest != "" && rest[0] >= '0' && rest[0] <= '9'
+}
+
 // flagByName searches for a flagItem in the FlagSet with the given name.
-// It returns the found flagItem or nil if no match is found.
+// If there is no flag with exactly the same name, the name is taken as the abbreviation
+// of the flag name, unless abbreviations are disabled, and the flag is returned if the
+// abbreviation is unique. It returns the found flagItem or nil if no match is found.
 func (fs *FlagSet) flagByName(name string) flagItem {
+	if f := fs.flagByExactName(name); f != nil {
+		return f
+	}
+	if candidates := fs.flagsByPrefix(name); len(candidates) == 1 {
+		return candidates[0]
+	}
+	return nil
+}
+
+// flagByExactName searches for a flagItem in the FlagSet with exactly the given name,
+// or with the given alias. Names are matched case-sensitively, unless the FlagSet
+// is built to ignore the case. It returns the found flagItem or nil if no match is found.
+func (fs *FlagSet) flagByExactName(name string) flagItem {
 	idx := slices.IndexFunc(fs.flags, func(f flagItem) bool {
-		return strings.EqualFold(f.Name(), name)
+		return slices.ContainsFunc(allNames(f), func(n string) bool { return fs.namesEqual(n, name) })
 	})
 	if idx == -1 {
 		return nil
@@ -182,11 +439,106 @@ func (fs *FlagSet) flagByName(name string) flagItem {
 	return fs.flags[idx]
 }
 
+// flagsByPrefix returns the flags whose names or aliases, except the deprecated ones,
+// start with the given prefix. Hidden flags are never matched, so they have to be
+// given with the complete name. It returns nil if the prefix is empty or abbreviations are disabled.
+func (fs *FlagSet) flagsByPrefix(prefix string) []flagItem {
+	if prefix == "" || fs.noAbbrev {
+		return nil
+	}
+	if fs.ignoreCase {
+		prefix = strings.ToLower(prefix)
+	}
+	var found []flagItem
+	for _, f := range fs.flags {
+		if f.IsHidden() {
+			continue
+		}
+		names := append([]string{f.Name()}, f.Aliases()...)
+		if slices.ContainsFunc(names, func(n string) bool {
+			if fs.ignoreCase {
+				n = strings.ToLower(n)
+			}
+			return strings.HasPrefix(n, prefix)
+		}) {
+			found = append(found, f)
+		}
+	}
+	return found
+}
+
+// namesEqual reports whether the flag names are the same, with respect to the case sensitivity of the FlagSet.
+func (fs *FlagSet) namesEqual(a, b string) bool {
+	if fs.ignoreCase {
+		return strings.EqualFold(a, b)
+	}
+	return a == b
+}
+
+// allNames returns the name of the flag followed by all its aliases.
+func allNames(f flagItem) []string {
+	names := append([]string{f.Name()}, f.Aliases()...)
+	return append(names, f.DeprecatedAliases()...)
+}
+
+// negatedNames returns the names of the flag prefixed with `no-`, if the flag is negatable.
+func negatedNames(f flagItem) []string {
+	if !f.IsNegatable() {
+		return nil
+	}
+	names := allNames(f)
+	for i, name := range names {
+		names[i] = negationPrefix + name
+	}
+	return names
+}
+
+// negationClash returns the name of the flag f, or of the bound flag, which is the same as
+// the negated form of the other one, e.g. `no-cache` next to the negatable `cache`, along with
+// the name of the negatable flag. It returns empty strings if there is no such name.
+func (fs *FlagSet) negationClash(f flagItem) (string, string) {
+	for _, name := range negatedNames(f) {
+		if fs.flagByExactName(name) != nil {
+			return name, f.Name()
+		}
+	}
+	for _, fl := range fs.flags {
+		for _, name := range negatedNames(fl) {
+			if slices.ContainsFunc(allNames(f), func(n string) bool { return fs.namesEqual(n, name) }) {
+				return name, fl.Name()
+			}
+		}
+	}
+	return "", ""
+}
+
+// unknownFlag returns the error for the flag name which does not match any flag,
+// suggesting the similar flag names. If the name is the abbreviation of more than
+// one flag name, the error lists them all.
+func (fs *FlagSet) unknownFlag(name string) error {
+	candidates := fs.flagsByPrefix(name)
+	if len(candidates) < 2 {
+		return &ferrors.UnknownFlagError{
+			Name:        name,
+			SingleDash:  fs.mode == ParseModeGo,
+			Suggestions: fs.suggestFlags(name),
+		}
+	}
+	names := make([]string, 0, len(candidates))
+	for _, f := range candidates {
+		names = append(names, f.Name())
+	}
+	return ferrors.AmbiguousFlag(name, names)
+}
+
 // flagByShorthand returns the flagItem with the given shorthand from the FlagSet.
-// If the shorthand is not found, it returns nil.
+// Shorthands are always matched case-sensitively. If the shorthand is empty or not found, it returns nil.
 func (fs *FlagSet) flagByShorthand(shorthand string) flagItem {
+	if shorthand == "" {
+		return nil
+	}
 	idx := slices.IndexFunc(fs.flags, func(f flagItem) bool {
-		return strings.EqualFold(f.Shorthand(), shorthand)
+		return f.Shorthand() == shorthand
 	})
 	if idx == -1 {
 		return nil
@@ -198,14 +550,20 @@ func (fs *FlagSet) flagByShorthand(shorthand string) flagItem {
 // If a duplicate flag is found, it prints an error message to stderr and exits the program.
 // Otherwise, it adds the flag to the `flags` slice of the FlagSet.
 func (fs *FlagSet) addFlag(f flagItem) {
-	if fl := fs.flagByName(f.Name()); fl != nil {
-		_, _ = fmt.Fprintf(os.Stderr, "flag \"%s\" already defined\n", f.Name())
-		os.Exit(1)
+	for _, name := range allNames(f) {
+		if fl := fs.flagByExactName(name); fl != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "flag \"%s\" already defined\n", name)
+			os.Exit(1)
+		}
 	}
 	if f.Shorthand() != "" && fs.flagByShorthand(f.Shorthand()) != nil {
 		_, _ = fmt.Fprintf(os.Stderr, "flag with shorthand \"%s\" already defined\n", f.Shorthand())
 		os.Exit(1)
 	}
+	if name, negatable := fs.negationClash(f); name != "" {
+		_, _ = fmt.Fprintf(os.Stderr, "flag \"%s\" clashes with the negated form of flag \"%s\"\n", name, negatable)
+		os.Exit(1)
+	}
 	set := make([]flagItem, len(fs.flags)+1)
 	copy(set, fs.flags)
 	set[len(fs.flags)] = f
@@ -218,7 +576,7 @@ func (fs *FlagSet) addFlag(f flagItem) {
 //   - flag value is nil
 //   - flag value has a different type
 func GetString(fs *FlagSet, name string) string {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[string](f.Value())
 }
 
@@ -228,7 +586,7 @@ func GetString(fs *FlagSet, name string) string {
 //   - flag does not exist
 //   - flag value has a different type
 func GetStringPtr(fs *FlagSet, name string) *string {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[string](f.Value())
 }
 
@@ -238,7 +596,7 @@ func GetStringPtr(fs *FlagSet, name string) *string {
 //   - flag value is nil
 //   - flag value has a different type
 func GetBool(fs *FlagSet, name string) bool {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[bool](f.Value())
 }
 
@@ -248,17 +606,37 @@ func GetBool(fs *FlagSet, name string) bool {
 //   - flag does not exist
 //   - flag value has a different type
 func GetBoolPtr(fs *FlagSet, name string) *bool {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[bool](f.Value())
 }
 
+// GetTriBool returns the flag.TriState value associated with the given name from the FlagSet.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value is nil
+//   - flag value has a different type
+func GetTriBool(fs *FlagSet, name string) flag.TriState {
+	f := fs.flagByExactName(name)
+	return flag.DerefOrDie[flag.TriState](f.Value())
+}
+
+// GetTriBoolPtr returns a pointer to a flag.TriState value associated with the given name from the FlagSet.
+// If the flag value is not set, it returns nil.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value has a different type
+func GetTriBoolPtr(fs *FlagSet, name string) *flag.TriState {
+	f := fs.flagByExactName(name)
+	return flag.PtrOrDie[flag.TriState](f.Value())
+}
+
 // GetDuration returns the time.Duration value associated with the given name from the FlagSet.
 // It will exit with code 1 if:
 //   - flag does not exist
 //   - flag value is nil
 //   - flag value has a different type
 func GetDuration(fs *FlagSet, name string) time.Duration {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[time.Duration](f.Value())
 }
 
@@ -268,17 +646,37 @@ func GetDuration(fs *FlagSet, name string) time.Duration {
 //   - flag does not exist
 //   - flag value has a different type
 func GetDurationPtr(fs *FlagSet, name string) *time.Duration {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[time.Duration](f.Value())
 }
 
+// GetTime returns the time.Time value associated with the given name from the FlagSet.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value is nil
+//   - flag value has a different type
+func GetTime(fs *FlagSet, name string) time.Time {
+	f := fs.flagByExactName(name)
+	return flag.DerefOrDie[time.Time](f.Value())
+}
+
+// GetTimePtr returns a pointer to a time.Time value associated with the given name from the FlagSet.
+// If the flag value is not set, it returns nil.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value has a different type
+func GetTimePtr(fs *FlagSet, name string) *time.Time {
+	f := fs.flagByExactName(name)
+	return flag.PtrOrDie[time.Time](f.Value())
+}
+
 // GetInt returns the int value associated with the given name from the FlagSet.
 // It will exit with code 1 if:
 //   - flag does not exist
 //   - flag value is nil
 //   - flag value has a different type
 func GetInt(fs *FlagSet, name string) int {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[int](f.Value())
 }
 
@@ -288,7 +686,7 @@ func GetInt(fs *FlagSet, name string) int {
 //   - flag does not exist
 //   - flag value has a different type
 func GetIntPtr(fs *FlagSet, name string) *int {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[int](f.Value())
 }
 
@@ -298,7 +696,7 @@ func GetIntPtr(fs *FlagSet, name string) *int {
 //   - flag value is nil
 //   - flag value has a different type
 func GetInt8(fs *FlagSet, name string) int8 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[int8](f.Value())
 }
 
@@ -308,7 +706,7 @@ func GetInt8(fs *FlagSet, name string) int8 {
 //   - flag does not exist
 //   - flag value has a different type
 func GetInt8Ptr(fs *FlagSet, name string) *int8 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[int8](f.Value())
 }
 
@@ -318,7 +716,7 @@ func GetInt8Ptr(fs *FlagSet, name string) *int8 {
 //   - flag value is nil
 //   - flag value has a different type
 func GetInt16(fs *FlagSet, name string) int16 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[int16](f.Value())
 }
 
@@ -328,7 +726,7 @@ func GetInt16(fs *FlagSet, name string) int16 {
 //   - flag does not exist
 //   - flag value has a different type
 func GetInt16Ptr(fs *FlagSet, name string) *int16 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[int16](f.Value())
 }
 
@@ -338,7 +736,7 @@ func GetInt16Ptr(fs *FlagSet, name string) *int16 {
 //   - flag value is nil
 //   - flag value has a different type
 func GetInt32(fs *FlagSet, name string) int32 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[int32](f.Value())
 }
 
@@ -348,7 +746,7 @@ func GetInt32(fs *FlagSet, name string) int32 {
 //   - flag does not exist
 //   - flag value has a different type
 func GetInt32Ptr(fs *FlagSet, name string) *int32 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[int32](f.Value())
 }
 
@@ -358,7 +756,7 @@ func GetInt32Ptr(fs *FlagSet, name string) *int32 {
 //   - flag value is nil
 //   - flag value has a different type
 func GetInt64(fs *FlagSet, name string) int64 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[int64](f.Value())
 }
 
@@ -368,7 +766,7 @@ func GetInt64(fs *FlagSet, name string) int64 {
 //   - flag does not exist
 //   - flag value has a different type
 func GetInt64Ptr(fs *FlagSet, name string) *int64 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[int64](f.Value())
 }
 
@@ -378,7 +776,7 @@ func GetInt64Ptr(fs *FlagSet, name string) *int64 {
 //   - flag value is nil
 //   - flag value has a different type
 func GetUint(fs *FlagSet, name string) uint {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[uint](f.Value())
 }
 
@@ -388,7 +786,7 @@ func GetUint(fs *FlagSet, name string) uint {
 //   - flag does not exist
 //   - flag value has a different type
 func GetUintPtr(fs *FlagSet, name string) *uint {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[uint](f.Value())
 }
 
@@ -398,7 +796,7 @@ func GetUintPtr(fs *FlagSet, name string) *uint {
 //   - flag value is nil
 //   - flag value has a different type
 func GetUint8(fs *FlagSet, name string) uint8 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[uint8](f.Value())
 }
 
@@ -408,7 +806,7 @@ func GetUint8(fs *FlagSet, name string) uint8 {
 //   - flag does not exist
 //   - flag value has a different type
 func GetUint8Ptr(fs *FlagSet, name string) *uint8 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[uint8](f.Value())
 }
 
@@ -418,7 +816,7 @@ func GetUint8Ptr(fs *FlagSet, name string) *uint8 {
 //   - flag value is nil
 //   - flag value has a different type
 func GetUint16(fs *FlagSet, name string) uint16 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[uint16](f.Value())
 }
 
@@ -428,7 +826,7 @@ func GetUint16(fs *FlagSet, name string) uint16 {
 //   - flag does not exist
 //   - flag value has a different type
 func GetUint16Ptr(fs *FlagSet, name string) *uint16 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[uint16](f.Value())
 }
 
@@ -438,7 +836,7 @@ func GetUint16Ptr(fs *FlagSet, name string) *uint16 {
 //   - flag value is nil
 //   - flag value has a different type
 func GetUint32(fs *FlagSet, name string) uint32 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[uint32](f.Value())
 }
 
@@ -448,7 +846,7 @@ func GetUint32(fs *FlagSet, name string) uint32 {
 //   - flag does not exist
 //   - flag value has a different type
 func GetUint32Ptr(fs *FlagSet, name string) *uint32 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[uint32](f.Value())
 }
 
@@ -458,7 +856,7 @@ func GetUint32Ptr(fs *FlagSet, name string) *uint32 {
 //   - flag value is nil
 //   - flag value has a different type
 func GetUint64(fs *FlagSet, name string) uint64 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[uint64](f.Value())
 }
 
@@ -468,7 +866,7 @@ func GetUint64(fs *FlagSet, name string) uint64 {
 //   - flag does not exist
 //   - flag value has a different type
 func GetUint64Ptr(fs *FlagSet, name string) *uint64 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[uint64](f.Value())
 }
 
@@ -478,7 +876,7 @@ func GetUint64Ptr(fs *FlagSet, name string) *uint64 {
 //   - flag value is nil
 //   - flag value has a different type
 func GetFloat32(fs *FlagSet, name string) float32 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[float32](f.Value())
 }
 
@@ -488,7 +886,7 @@ func GetFloat32(fs *FlagSet, name string) float32 {
 //   - flag does not exist
 //   - flag value has a different type
 func GetFloat32Ptr(fs *FlagSet, name string) *float32 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[float32](f.Value())
 }
 
@@ -498,7 +896,7 @@ func GetFloat32Ptr(fs *FlagSet, name string) *float32 {
 //   - flag value is nil
 //   - flag value has a different type
 func GetFloat64(fs *FlagSet, name string) float64 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[float64](f.Value())
 }
 
@@ -508,7 +906,7 @@ func GetFloat64(fs *FlagSet, name string) float64 {
 //   - flag does not exist
 //   - flag value has a different type
 func GetFloat64Ptr(fs *FlagSet, name string) *float64 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[float64](f.Value())
 }
 
@@ -518,7 +916,7 @@ func GetFloat64Ptr(fs *FlagSet, name string) *float64 {
 //   - flag value is nil
 //   - flag value has a different type
 func GetIntSlice(fs *FlagSet, name string) []int {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[[]int](f.Value())
 }
 
@@ -528,7 +926,7 @@ func GetIntSlice(fs *FlagSet, name string) []int {
 //   - flag does not exist
 //   - flag value has a different type
 func GetIntSlicePtr(fs *FlagSet, name string) *[]int {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[[]int](f.Value())
 }
 
@@ -538,7 +936,7 @@ func GetIntSlicePtr(fs *FlagSet, name string) *[]int {
 //   - flag value is nil
 //   - flag value has a different type
 func GetInt8Slice(fs *FlagSet, name string) []int8 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[[]int8](f.Value())
 }
 
@@ -548,7 +946,7 @@ func GetInt8Slice(fs *FlagSet, name string) []int8 {
 //   - flag does not exist
 //   - flag value has a different type
 func GetInt8SlicePtr(fs *FlagSet, name string) *[]int8 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[[]int8](f.Value())
 }
 
@@ -558,7 +956,7 @@ func GetInt8SlicePtr(fs *FlagSet, name string) *[]int8 {
 //   - flag value is nil
 //   - flag value has a different type
 func GetInt16Slice(fs *FlagSet, name string) []int16 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[[]int16](f.Value())
 }
 
@@ -568,7 +966,7 @@ func GetInt16Slice(fs *FlagSet, name string) []int16 {
 //   - flag does not exist
 //   - flag value has a different type
 func GetInt16SlicePtr(fs *FlagSet, name string) *[]int16 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[[]int16](f.Value())
 }
 
@@ -578,7 +976,7 @@ func GetInt16SlicePtr(fs *FlagSet, name string) *[]int16 {
 //   - flag value is nil
 //   - flag value has a different type
 func GetInt32Slice(fs *FlagSet, name string) []int32 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[[]int32](f.Value())
 }
 
@@ -588,7 +986,7 @@ func GetInt32Slice(fs *FlagSet, name string) []int32 {
 //   - flag does not exist
 //   - flag value has a different type
 func GetInt32SlicePtr(fs *FlagSet, name string) *[]int32 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[[]int32](f.Value())
 }
 
@@ -598,7 +996,7 @@ func GetInt32SlicePtr(fs *FlagSet, name string) *[]int32 {
 //   - flag value is nil
 //   - flag value has a different type
 func GetInt64Slice(fs *FlagSet, name string) []int64 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[[]int64](f.Value())
 }
 
@@ -608,7 +1006,7 @@ func GetInt64Slice(fs *FlagSet, name string) []int64 {
 //   - flag does not exist
 //   - flag value has a different type
 func GetInt64SlicePtr(fs *FlagSet, name string) *[]int64 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[[]int64](f.Value())
 }
 
@@ -618,7 +1016,7 @@ func GetInt64SlicePtr(fs *FlagSet, name string) *[]int64 {
 //   - flag value is nil
 //   - flag value has a different type
 func GetUintSlice(fs *FlagSet, name string) []uint {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[[]uint](f.Value())
 }
 
@@ -628,7 +1026,7 @@ func GetUintSlice(fs *FlagSet, name string) []uint {
 //   - flag does not exist
 //   - flag value has a different type
 func GetUintSlicePtr(fs *FlagSet, name string) *[]uint {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[[]uint](f.Value())
 }
 
@@ -638,7 +1036,7 @@ func GetUintSlicePtr(fs *FlagSet, name string) *[]uint {
 //   - flag value is nil
 //   - flag value has a different type
 func GetUint8Slice(fs *FlagSet, name string) []uint8 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[[]uint8](f.Value())
 }
 
@@ -648,7 +1046,7 @@ func GetUint8Slice(fs *FlagSet, name string) []uint8 {
 //   - flag does not exist
 //   - flag value has a different type
 func GetUint8SlicePtr(fs *FlagSet, name string) *[]uint8 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[[]uint8](f.Value())
 }
 
@@ -658,7 +1056,7 @@ func GetUint8SlicePtr(fs *FlagSet, name string) *[]uint8 {
 //   - flag value is nil
 //   - flag value has a different type
 func GetUint16Slice(fs *FlagSet, name string) []uint16 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[[]uint16](f.Value())
 }
 
@@ -668,7 +1066,7 @@ func GetUint16Slice(fs *FlagSet, name string) []uint16 {
 //   - flag does not exist
 //   - flag value has a different type
 func GetUint16SlicePtr(fs *FlagSet, name string) *[]uint16 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[[]uint16](f.Value())
 }
 
@@ -678,7 +1076,7 @@ func GetUint16SlicePtr(fs *FlagSet, name string) *[]uint16 {
 //   - flag value is nil
 //   - flag value has a different type
 func GetUint32Slice(fs *FlagSet, name string) []uint32 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[[]uint32](f.Value())
 }
 
@@ -688,7 +1086,7 @@ func GetUint32Slice(fs *FlagSet, name string) []uint32 {
 //   - flag does not exist
 //   - flag value has a different type
 func GetUint32SlicePtr(fs *FlagSet, name string) *[]uint32 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[[]uint32](f.Value())
 }
 
@@ -698,7 +1096,7 @@ func GetUint32SlicePtr(fs *FlagSet, name string) *[]uint32 {
 //   - flag value is nil
 //   - flag value has a different type
 func GetUint64Slice(fs *FlagSet, name string) []uint64 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[[]uint64](f.Value())
 }
 
@@ -708,7 +1106,7 @@ func GetUint64Slice(fs *FlagSet, name string) []uint64 {
 //   - flag does not exist
 //   - flag value has a different type
 func GetUint64SlicePtr(fs *FlagSet, name string) *[]uint64 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[[]uint64](f.Value())
 }
 
@@ -718,7 +1116,7 @@ func GetUint64SlicePtr(fs *FlagSet, name string) *[]uint64 {
 //   - flag value is nil
 //   - flag value has a different type
 func GetFloat32Slice(fs *FlagSet, name string) []float32 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[[]float32](f.Value())
 }
 
@@ -728,7 +1126,7 @@ func GetFloat32Slice(fs *FlagSet, name string) []float32 {
 //   - flag does not exist
 //   - flag value has a different type
 func GetFloat32SlicePtr(fs *FlagSet, name string) *[]float32 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[[]float32](f.Value())
 }
 
@@ -738,7 +1136,7 @@ func GetFloat32SlicePtr(fs *FlagSet, name string) *[]float32 {
 //   - flag value is nil
 //   - flag value has a different type
 func GetFloat64Slice(fs *FlagSet, name string) []float64 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[[]float64](f.Value())
 }
 
@@ -748,7 +1146,7 @@ func GetFloat64Slice(fs *FlagSet, name string) []float64 {
 //   - flag does not exist
 //   - flag value has a different type
 func GetFloat64SlicePtr(fs *FlagSet, name string) *[]float64 {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[[]float64](f.Value())
 }
 
@@ -758,7 +1156,7 @@ func GetFloat64SlicePtr(fs *FlagSet, name string) *[]float64 {
 //   - flag value is nil
 //   - flag value has a different type
 func GetStringSlice(fs *FlagSet, name string) []string {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[[]string](f.Value())
 }
 
@@ -768,7 +1166,7 @@ func GetStringSlice(fs *FlagSet, name string) []string {
 //   - flag does not exist
 //   - flag value has a different type
 func GetStringSlicePtr(fs *FlagSet, name string) *[]string {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[[]string](f.Value())
 }
 
@@ -778,7 +1176,7 @@ func GetStringSlicePtr(fs *FlagSet, name string) *[]string {
 //   - flag value is nil
 //   - flag value has a different type
 func GetDurationSlice(fs *FlagSet, name string) []time.Duration {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[[]time.Duration](f.Value())
 }
 
@@ -788,7 +1186,7 @@ func GetDurationSlice(fs *FlagSet, name string) []time.Duration {
 //   - flag does not exist
 //   - flag value has a different type
 func GetDurationSlicePtr(fs *FlagSet, name string) *[]time.Duration {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[[]time.Duration](f.Value())
 }
 
@@ -798,7 +1196,7 @@ func GetDurationSlicePtr(fs *FlagSet, name string) *[]time.Duration {
 //   - flag value is nil
 //   - flag value has a different type
 func GetBoolSlice(fs *FlagSet, name string) []bool {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[[]bool](f.Value())
 }
 
@@ -808,17 +1206,317 @@ func GetBoolSlice(fs *FlagSet, name string) []bool {
 //   - flag does not exist
 //   - flag value has a different type
 func GetBoolSlicePtr(fs *FlagSet, name string) *[]bool {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[[]bool](f.Value())
 }
 
+// GetStringMap returns the map[string]string value associated with the given name from the FlagSet.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value is nil
+//   - flag value has a different type
+func GetStringMap(fs *FlagSet, name string) map[string]string {
+	f := fs.flagByExactName(name)
+	return flag.DerefOrDie[map[string]string](f.Value())
+}
+
+// GetStringMapPtr returns a pointer to a map[string]string value associated with the given name from the FlagSet.
+// If the flag value is not set, it returns nil.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value has a different type
+func GetStringMapPtr(fs *FlagSet, name string) *map[string]string {
+	f := fs.flagByExactName(name)
+	return flag.PtrOrDie[map[string]string](f.Value())
+}
+
+// GetIntMap returns the map[string]int value associated with the given name from the FlagSet.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value is nil
+//   - flag value has a different type
+func GetIntMap(fs *FlagSet, name string) map[string]int {
+	f := fs.flagByExactName(name)
+	return flag.DerefOrDie[map[string]int](f.Value())
+}
+
+// GetIntMapPtr returns a pointer to a map[string]int value associated with the given name from the FlagSet.
+// If the flag value is not set, it returns nil.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value has a different type
+func GetIntMapPtr(fs *FlagSet, name string) *map[string]int {
+	f := fs.flagByExactName(name)
+	return flag.PtrOrDie[map[string]int](f.Value())
+}
+
+// GetMap returns the map[K]V value associated with the given name from the FlagSet.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value is nil
+//   - flag value has a different type
+func GetMap[K comparable, V any](fs *FlagSet, name string) map[K]V {
+	f := fs.flagByExactName(name)
+	return flag.DerefOrDie[map[K]V](f.Value())
+}
+
+// GetMapPtr returns a pointer to a map[K]V value associated with the given name from the FlagSet.
+// If the flag value is not set, it returns nil.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value has a different type
+func GetMapPtr[K comparable, V any](fs *FlagSet, name string) *map[K]V {
+	f := fs.flagByExactName(name)
+	return flag.PtrOrDie[map[K]V](f.Value())
+}
+
+// GetIP returns the netip.Addr value associated with the given name from the FlagSet.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value is nil
+//   - flag value has a different type
+func GetIP(fs *FlagSet, name string) netip.Addr {
+	f := fs.flagByExactName(name)
+	return flag.DerefOrDie[netip.Addr](f.Value())
+}
+
+// GetIPPtr returns a pointer to a netip.Addr value associated with the given name from the FlagSet.
+// If the flag value is not set, it returns nil.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value has a different type
+func GetIPPtr(fs *FlagSet, name string) *netip.Addr {
+	f := fs.flagByExactName(name)
+	return flag.PtrOrDie[netip.Addr](f.Value())
+}
+
+// GetIPSlice returns the []netip.Addr value associated with the given name from the FlagSet.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value is nil
+//   - flag value has a different type
+func GetIPSlice(fs *FlagSet, name string) []netip.Addr {
+	f := fs.flagByExactName(name)
+	return flag.DerefOrDie[[]netip.Addr](f.Value())
+}
+
+// GetIPSlicePtr returns a pointer to a []netip.Addr value associated with the given name from the FlagSet.
+// If the flag value is not set, it returns nil.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value has a different type
+func GetIPSlicePtr(fs *FlagSet, name string) *[]netip.Addr {
+	f := fs.flagByExactName(name)
+	return flag.PtrOrDie[[]netip.Addr](f.Value())
+}
+
+// GetPrefix returns the netip.Prefix value associated with the given name from the FlagSet.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value is nil
+//   - flag value has a different type
+func GetPrefix(fs *FlagSet, name string) netip.Prefix {
+	f := fs.flagByExactName(name)
+	return flag.DerefOrDie[netip.Prefix](f.Value())
+}
+
+// GetPrefixPtr returns a pointer to a netip.Prefix value associated with the given name from the FlagSet.
+// If the flag value is not set, it returns nil.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value has a different type
+func GetPrefixPtr(fs *FlagSet, name string) *netip.Prefix {
+	f := fs.flagByExactName(name)
+	return flag.PtrOrDie[netip.Prefix](f.Value())
+}
+
+// GetPrefixSlice returns the []netip.Prefix value associated with the given name from the FlagSet.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value is nil
+//   - flag value has a different type
+func GetPrefixSlice(fs *FlagSet, name string) []netip.Prefix {
+	f := fs.flagByExactName(name)
+	return flag.DerefOrDie[[]netip.Prefix](f.Value())
+}
+
+// GetPrefixSlicePtr returns a pointer to a []netip.Prefix value associated with the given name from the FlagSet.
+// If the flag value is not set, it returns nil.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value has a different type
+func GetPrefixSlicePtr(fs *FlagSet, name string) *[]netip.Prefix {
+	f := fs.flagByExactName(name)
+	return flag.PtrOrDie[[]netip.Prefix](f.Value())
+}
+
+// GetHostPort returns the flag.Endpoint value associated with the given name from the FlagSet.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value is nil
+//   - flag value has a different type
+func GetHostPort(fs *FlagSet, name string) flag.Endpoint {
+	f := fs.flagByExactName(name)
+	return flag.DerefOrDie[flag.Endpoint](f.Value())
+}
+
+// GetHostPortPtr returns a pointer to a flag.Endpoint value associated with the given name from the FlagSet.
+// If the flag value is not set, it returns nil.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value has a different type
+func GetHostPortPtr(fs *FlagSet, name string) *flag.Endpoint {
+	f := fs.flagByExactName(name)
+	return flag.PtrOrDie[flag.Endpoint](f.Value())
+}
+
+// GetHostPortSlice returns the []flag.Endpoint value associated with the given name from the FlagSet.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value is nil
+//   - flag value has a different type
+func GetHostPortSlice(fs *FlagSet, name string) []flag.Endpoint {
+	f := fs.flagByExactName(name)
+	return flag.DerefOrDie[[]flag.Endpoint](f.Value())
+}
+
+// GetHostPortSlicePtr returns a pointer to a []flag.Endpoint value associated with the given name from the FlagSet.
+// If the flag value is not set, it returns nil.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value has a different type
+func GetHostPortSlicePtr(fs *FlagSet, name string) *[]flag.Endpoint {
+	f := fs.flagByExactName(name)
+	return flag.PtrOrDie[[]flag.Endpoint](f.Value())
+}
+
+// GetURL returns the url.URL value associated with the given name from the FlagSet.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value is nil
+//   - flag value has a different type
+func GetURL(fs *FlagSet, name string) url.URL {
+	f := fs.flagByExactName(name)
+	return flag.DerefOrDie[url.URL](f.Value())
+}
+
+// GetURLPtr returns a pointer to a url.URL value associated with the given name from the FlagSet.
+// If the flag value is not set, it returns nil.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value has a different type
+func GetURLPtr(fs *FlagSet, name string) *url.URL {
+	f := fs.flagByExactName(name)
+	return flag.PtrOrDie[url.URL](f.Value())
+}
+
+// GetURLSlice returns the []url.URL value associated with the given name from the FlagSet.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value is nil
+//   - flag value has a different type
+func GetURLSlice(fs *FlagSet, name string) []url.URL {
+	f := fs.flagByExactName(name)
+	return flag.DerefOrDie[[]url.URL](f.Value())
+}
+
+// GetURLSlicePtr returns a pointer to a []url.URL value associated with the given name from the FlagSet.
+// If the flag value is not set, it returns nil.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value has a different type
+func GetURLSlicePtr(fs *FlagSet, name string) *[]url.URL {
+	f := fs.flagByExactName(name)
+	return flag.PtrOrDie[[]url.URL](f.Value())
+}
+
+// GetByteSize returns the flag.ByteSizeValue value associated with the given name from the FlagSet.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value is nil
+//   - flag value has a different type
+func GetByteSize(fs *FlagSet, name string) flag.ByteSizeValue {
+	f := fs.flagByExactName(name)
+	return flag.DerefOrDie[flag.ByteSizeValue](f.Value())
+}
+
+// GetByteSizePtr returns a pointer to a flag.ByteSizeValue value associated with the given name from the FlagSet.
+// If the flag value is not set, it returns nil.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value has a different type
+func GetByteSizePtr(fs *FlagSet, name string) *flag.ByteSizeValue {
+	f := fs.flagByExactName(name)
+	return flag.PtrOrDie[flag.ByteSizeValue](f.Value())
+}
+
+// GetByteSizeSlice returns the []flag.ByteSizeValue value associated with the given name from the FlagSet.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value is nil
+//   - flag value has a different type
+func GetByteSizeSlice(fs *FlagSet, name string) []flag.ByteSizeValue {
+	f := fs.flagByExactName(name)
+	return flag.DerefOrDie[[]flag.ByteSizeValue](f.Value())
+}
+
+// GetByteSizeSlicePtr returns a pointer to a []flag.ByteSizeValue value associated with the given name from the FlagSet.
+// If the flag value is not set, it returns nil.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value has a different type
+func GetByteSizeSlicePtr(fs *FlagSet, name string) *[]flag.ByteSizeValue {
+	f := fs.flagByExactName(name)
+	return flag.PtrOrDie[[]flag.ByteSizeValue](f.Value())
+}
+
+// GetQuantity returns the flag.QuantityValue value associated with the given name from the FlagSet.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value is nil
+//   - flag value has a different type
+func GetQuantity(fs *FlagSet, name string) flag.QuantityValue {
+	f := fs.flagByExactName(name)
+	return flag.DerefOrDie[flag.QuantityValue](f.Value())
+}
+
+// GetQuantityPtr returns a pointer to a flag.QuantityValue value associated with the given name from the FlagSet.
+// If the flag value is not set, it returns nil.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value has a different type
+func GetQuantityPtr(fs *FlagSet, name string) *flag.QuantityValue {
+	f := fs.flagByExactName(name)
+	return flag.PtrOrDie[flag.QuantityValue](f.Value())
+}
+
+// GetQuantitySlice returns the []flag.QuantityValue value associated with the given name from the FlagSet.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value is nil
+//   - flag value has a different type
+func GetQuantitySlice(fs *FlagSet, name string) []flag.QuantityValue {
+	f := fs.flagByExactName(name)
+	return flag.DerefOrDie[[]flag.QuantityValue](f.Value())
+}
+
+// GetQuantitySlicePtr returns a pointer to a []flag.QuantityValue value associated with the given name from the FlagSet.
+// If the flag value is not set, it returns nil.
+// It will exit with code 1 if:
+//   - flag does not exist
+//   - flag value has a different type
+func GetQuantitySlicePtr(fs *FlagSet, name string) *[]flag.QuantityValue {
+	f := fs.flagByExactName(name)
+	return flag.PtrOrDie[[]flag.QuantityValue](f.Value())
+}
+
 // GetCounter returns the uint64 value, reflecting the counter, associated with the given name from the FlagSet.
 // It will exit with code 1 if:
 //   - flag does not exist
 //   - flag value is nil
 //   - flag value has a different type
 func GetCounter(fs *FlagSet, name string) int {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[int](f.Value())
 }
 
@@ -828,7 +1526,7 @@ func GetCounter(fs *FlagSet, name string) int {
 //   - flag value is nil
 //   - flag value has a different type
 func GetTypedFlag[T any](fs *FlagSet, name string) T {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.DerefOrDie[T](f.Value())
 }
 
@@ -839,6 +1537,6 @@ func GetTypedFlag[T any](fs *FlagSet, name string) T {
 //   - flag does not exist
 //   - flag value has a different type
 func GetTypedFlagPtr[T any](fs *FlagSet, name string) *T {
-	f := fs.flagByName(name)
+	f := fs.flagByExactName(name)
 	return flag.PtrOrDie[T](f.Value())
 }
@@ -1,9 +1,17 @@
 package flagset
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/netip"
+	"net/url"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -29,6 +37,12 @@ func assertParsedValue(t *testing.T, fs *FlagSet, r result) {
 		ptr := GetStringSlicePtr(fs, r.flagName)
 		require.NotNil(t, ptr)
 		assert.Equal(t, r.flagValue, *ptr)
+	case "triBool":
+		val := GetTriBool(fs, r.flagName)
+		assert.Equal(t, r.flagValue, val)
+		ptr := GetTriBoolPtr(fs, r.flagName)
+		require.NotNil(t, ptr)
+		assert.Equal(t, r.flagValue, *ptr)
 	case "bool":
 		val := GetBool(fs, r.flagName)
 		assert.Equal(t, r.flagValue, val)
@@ -197,6 +211,96 @@ func assertParsedValue(t *testing.T, fs *FlagSet, r result) {
 		ptr := GetFloat64SlicePtr(fs, r.flagName)
 		require.NotNil(t, ptr)
 		assert.Equal(t, r.flagValue, *ptr)
+	case "stringMap":
+		val := GetStringMap(fs, r.flagName)
+		assert.Equal(t, r.flagValue, val)
+		ptr := GetStringMapPtr(fs, r.flagName)
+		require.NotNil(t, ptr)
+		assert.Equal(t, r.flagValue, *ptr)
+	case "intMap":
+		val := GetIntMap(fs, r.flagName)
+		assert.Equal(t, r.flagValue, val)
+		ptr := GetIntMapPtr(fs, r.flagName)
+		require.NotNil(t, ptr)
+		assert.Equal(t, r.flagValue, *ptr)
+	case "ip":
+		val := GetIP(fs, r.flagName)
+		assert.Equal(t, r.flagValue, val)
+		ptr := GetIPPtr(fs, r.flagName)
+		require.NotNil(t, ptr)
+		assert.Equal(t, r.flagValue, *ptr)
+	case "ipSlice":
+		val := GetIPSlice(fs, r.flagName)
+		assert.Equal(t, r.flagValue, val)
+		ptr := GetIPSlicePtr(fs, r.flagName)
+		require.NotNil(t, ptr)
+		assert.Equal(t, r.flagValue, *ptr)
+	case "prefix":
+		val := GetPrefix(fs, r.flagName)
+		assert.Equal(t, r.flagValue, val)
+		ptr := GetPrefixPtr(fs, r.flagName)
+		require.NotNil(t, ptr)
+		assert.Equal(t, r.flagValue, *ptr)
+	case "prefixSlice":
+		val := GetPrefixSlice(fs, r.flagName)
+		assert.Equal(t, r.flagValue, val)
+		ptr := GetPrefixSlicePtr(fs, r.flagName)
+		require.NotNil(t, ptr)
+		assert.Equal(t, r.flagValue, *ptr)
+	case "hostPort":
+		val := GetHostPort(fs, r.flagName)
+		assert.Equal(t, r.flagValue, val)
+		ptr := GetHostPortPtr(fs, r.flagName)
+		require.NotNil(t, ptr)
+		assert.Equal(t, r.flagValue, *ptr)
+	case "hostPortSlice":
+		val := GetHostPortSlice(fs, r.flagName)
+		assert.Equal(t, r.flagValue, val)
+		ptr := GetHostPortSlicePtr(fs, r.flagName)
+		require.NotNil(t, ptr)
+		assert.Equal(t, r.flagValue, *ptr)
+	case "url":
+		val := GetURL(fs, r.flagName)
+		assert.Equal(t, r.flagValue, val)
+		ptr := GetURLPtr(fs, r.flagName)
+		require.NotNil(t, ptr)
+		assert.Equal(t, r.flagValue, *ptr)
+	case "urlSlice":
+		val := GetURLSlice(fs, r.flagName)
+		assert.Equal(t, r.flagValue, val)
+		ptr := GetURLSlicePtr(fs, r.flagName)
+		require.NotNil(t, ptr)
+		assert.Equal(t, r.flagValue, *ptr)
+	case "byteSize":
+		val := GetByteSize(fs, r.flagName)
+		assert.Equal(t, r.flagValue, val)
+		ptr := GetByteSizePtr(fs, r.flagName)
+		require.NotNil(t, ptr)
+		assert.Equal(t, r.flagValue, *ptr)
+	case "byteSizeSlice":
+		val := GetByteSizeSlice(fs, r.flagName)
+		assert.Equal(t, r.flagValue, val)
+		ptr := GetByteSizeSlicePtr(fs, r.flagName)
+		require.NotNil(t, ptr)
+		assert.Equal(t, r.flagValue, *ptr)
+	case "quantity":
+		val := GetQuantity(fs, r.flagName)
+		assert.Equal(t, r.flagValue, val)
+		ptr := GetQuantityPtr(fs, r.flagName)
+		require.NotNil(t, ptr)
+		assert.Equal(t, r.flagValue, *ptr)
+	case "quantitySlice":
+		val := GetQuantitySlice(fs, r.flagName)
+		assert.Equal(t, r.flagValue, val)
+		ptr := GetQuantitySlicePtr(fs, r.flagName)
+		require.NotNil(t, ptr)
+		assert.Equal(t, r.flagValue, *ptr)
+	case "time":
+		val := GetTime(fs, r.flagName)
+		assert.Equal(t, r.flagValue, val)
+		ptr := GetTimePtr(fs, r.flagName)
+		require.NotNil(t, ptr)
+		assert.Equal(t, r.flagValue, *ptr)
 	case "counter":
 		val := GetCounter(fs, r.flagName)
 		assert.Equal(t, r.flagValue, val)
@@ -206,6 +310,9 @@ func assertParsedValue(t *testing.T, fs *FlagSet, r result) {
 		ptr := GetTypedFlagPtr[custom](fs, r.flagName)
 		require.NotNil(t, ptr)
 		assert.Equal(t, r.flagValue, *ptr)
+	case "slogLevel":
+		val := GetTypedFlag[slog.Level](fs, r.flagName)
+		assert.Equal(t, r.flagValue, val)
 	default:
 		t.Fatalf("unknown flag type: %s", r.flagType)
 	}
@@ -250,6 +357,18 @@ func (p *customParser) ParseEnv(s string) (any, error) {
 	return nil, nil
 }
 
+// notLessThanMin parses the integer and rejects it if the value of the "min" flag is greater.
+var notLessThanMin = parser.ContextParserFunc(func(ctx parser.Context, s string) (any, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, err
+	}
+	if m, ok := ctx.Lookup("min"); ok && m != nil && *(m.(*int)) > v {
+		return nil, ferrors.InvalidValue(s, "less than min")
+	}
+	return &v, nil
+})
+
 func TestFlagSet_Parse(t *testing.T) {
 	t.Parallel()
 	tests := []flagSetTest{
@@ -386,7 +505,7 @@ func TestFlagSet_Parse(t *testing.T) {
 				err:         true,
 				expectedErr: ferrors.ErrUnknownFlag,
 			},
-			input: []string{"--sample"},
+			input: []string{"--sample-int"},
 		},
 		{
 			name: "parse unknown shorthand error",
@@ -595,243 +714,938 @@ func TestFlagSet_Parse(t *testing.T) {
 			},
 		},
 		{
-			name: "custom flag no parser error",
+			name: "parse maps",
 			flagSet: func() *FlagSet {
-				fs := New().BindFlag(flag.Typed[custom]("sample")).Build()
+				fs := New().
+					BindFlag(flag.StringMap("label", flag.Shorthand("l"))).
+					BindFlag(flag.IntMap("limit")).
+					Build()
+				return fs
+			},
+			expected: expected{
+				parsed: []result{
+					{flagName: "label", flagValue: map[string]string{"env": "prod", "tier": "web"}, flagType: "stringMap"},
+					{flagName: "limit", flagValue: map[string]int{"cpu": 2, "memory": 512}, flagType: "intMap"},
+				},
+				err: false,
+			},
+			input: []string{
+				"--label", "env=prod",
+				"-l", "tier=web",
+				"--limit", "cpu=2,memory=512",
+			},
+		},
+		{
+			name: "parse map duplicate key error",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.StringMap("label", flag.DuplicateKeys(flag.DuplicateKeyError))).
+					Build()
 				return fs
 			},
 			expected: expected{
 				parsed:      []result{},
 				err:         true,
-				expectedErr: ferrors.ErrNoParserDefined,
+				expectedErr: ferrors.ErrDuplicateKey,
 			},
-			input: []string{"--sample"},
+			input: []string{"--label", "env=prod", "--label", "env=dev"},
 		},
 		{
-			name: "custom flag no error",
+			name: "parse choice",
 			flagSet: func() *FlagSet {
 				fs := New().
-					BindFlag(flag.Typed[custom]("sample", flag.Parser(&customParser{&parser.EmbeddedParser{}}))).
+					BindFlag(flag.Choice("format", []string{"json", "text"}, flag.IgnoreCase())).
 					Build()
 				return fs
 			},
 			expected: expected{
 				parsed: []result{
-					{flagName: "sample", flagValue: custom{field: 10}, flagType: "custom"},
+					{flagName: "format", flagValue: "json", flagType: "string"},
 				},
 				err: false,
 			},
-			input: []string{"--sample", "10"},
+			input: []string{"--format", "JSON"},
 		},
 		{
-			name: "custom flag parse error",
+			name: "parse choice error",
 			flagSet: func() *FlagSet {
 				fs := New().
-					BindFlag(flag.Typed[custom]("sample", flag.Parser(&customParser{&parser.EmbeddedParser{}}))).
+					BindFlag(flag.Choice("format", []string{"json", "text"})).
 					Build()
 				return fs
 			},
 			expected: expected{
 				parsed:      []result{},
 				err:         true,
-				expectedErr: ferrors.ErrParseFailed,
+				expectedErr: ferrors.ErrInvalidChoice,
 			},
-			input: []string{"--sample", "invalid"},
+			input: []string{"--format", "yaml"},
 		},
-	}
-
-	for _, tc := range tests {
-		tt := tc
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			fs := tt.flagSet()
-			err := fs.Parse(tt.input)
-			if tt.expected.err {
-				require.Error(t, err)
-				assert.True(t, errors.Is(err, tt.expected.expectedErr))
-				return
-			}
-			assert.NoError(t, err)
-			for _, r := range tt.expected.parsed {
-				require.NotNil(t, fs.flagByName(r.flagName).Value())
-				assertParsedValue(t, fs, r)
-			}
-		})
-	}
-}
-
-type envTestCase struct {
-	name      string
-	flagSet   func() *FlagSet
-	variables map[string]string
-	expected  expected
-}
-
-func TestFlagSet_BindEnvVars(t *testing.T) {
-	t.Parallel()
-
-	tests := []envTestCase{
 		{
-			name: "env vars success",
+			name: "parse network values",
 			flagSet: func() *FlagSet {
-				fs := New(env.Prefix("test1"), env.Capitalized(), env.VarNameReplace("-", "_")).
-					BindFlag(flag.String("sample-string")).
-					BindFlag(flag.Bool("sample-bool")).
-					BindFlag(flag.Counter("sample-counter")).
-					BindFlag(flag.Float32("sample-float32")).
-					BindFlag(flag.Float64("sample-float64")).
-					BindFlag(flag.Duration("sample-duration")).Build()
+				fs := New().
+					BindFlag(flag.IP("bind-ip")).
+					BindFlag(flag.IPSlice("dns")).
+					BindFlag(flag.Prefix("subnet")).
+					BindFlag(flag.PrefixSlice("allowed-subnets")).
+					BindFlag(flag.HostPort("listen")).
+					BindFlag(flag.HostPortSlice("peers")).
+					BindFlag(flag.URL("endpoint", flag.URLSchemes("https"))).
+					BindFlag(flag.URLSlice("mirrors")).
+					Build()
 				return fs
 			},
-			variables: map[string]string{
-				"TEST1_SAMPLE_STRING":   "foo",
-				"TEST1_SAMPLE_BOOL":     "true",
-				"TEST1_SAMPLE_COUNTER":  "1",
-				"TEST1_SAMPLE_FLOAT32":  "3.14",
-				"TEST1_SAMPLE_FLOAT64":  "3.14",
-				"TEST1_SAMPLE_DURATION": "15m",
-			},
 			expected: expected{
 				parsed: []result{
-					{flagName: "sample-duration", flagValue: time.Minute * 15, flagType: "duration"},
-					{flagName: "sample-string", flagValue: "foo", flagType: "string"},
-					{flagName: "sample-counter", flagValue: 1, flagType: "counter"},
-					{flagName: "sample-float32", flagValue: float32(3.14), flagType: "float32"},
-					{flagName: "sample-float64", flagValue: 3.14, flagType: "float64"},
-					{flagName: "sample-bool", flagValue: true, flagType: "bool"},
+					{flagName: "bind-ip", flagValue: netip.MustParseAddr("10.0.0.1"), flagType: "ip"},
+					{
+						flagName:  "dns",
+						flagValue: []netip.Addr{netip.MustParseAddr("1.1.1.1"), netip.MustParseAddr("8.8.8.8")},
+						flagType:  "ipSlice",
+					},
+					{flagName: "subnet", flagValue: netip.MustParsePrefix("10.0.0.0/24"), flagType: "prefix"},
+					{flagName: "allowed-subnets", flagValue: []netip.Prefix{netip.MustParsePrefix("fd00::/8")}, flagType: "prefixSlice"},
+					{flagName: "listen", flagValue: flag.Endpoint{Host: "0.0.0.0", Port: 8080}, flagType: "hostPort"},
+					{flagName: "peers", flagValue: []flag.Endpoint{{Host: "a", Port: 1}, {Host: "b", Port: 2}}, flagType: "hostPortSlice"},
+					{flagName: "endpoint", flagValue: url.URL{Scheme: "https", Host: "example.com"}, flagType: "url"},
+					{flagName: "mirrors", flagValue: []url.URL{{Scheme: "http", Host: "a"}}, flagType: "urlSlice"},
 				},
 				err: false,
 			},
+			input: []string{
+				"--bind-ip", "10.0.0.1",
+				"--dns", "1.1.1.1",
+				"--dns", "8.8.8.8",
+				"--subnet", "10.0.0.0/24",
+				"--allowed-subnets", "fd00::/8",
+				"--listen", "0.0.0.0:8080",
+				"--peers", "a:1,b:2",
+				"--endpoint", "https://example.com",
+				"--mirrors", "http://a",
+			},
 		},
 		{
-			name: "env vars failed",
+			name: "parse network constraint error",
 			flagSet: func() *FlagSet {
-				fs := New(env.Prefix("test2"), env.Capitalized(), env.VarNameReplace("-", "_")).
-					BindFlag(flag.String("sample-string")).
-					BindFlag(flag.Bool("sample-bool")).
-					BindFlag(flag.Counter("sample-counter")).
-					BindFlag(flag.Float32("sample-float32")).
-					BindFlag(flag.Float64("sample-float64")).
-					BindFlag(flag.Duration("sample-duration")).Build()
+				fs := New().
+					BindFlag(flag.URL("endpoint", flag.URLSchemes("https"))).
+					Build()
 				return fs
 			},
-			variables: map[string]string{
-				"TEST2_SAMPLE_STRING":   "foo",
-				"TEST2_SAMPLE_BOOL":     "yes",
-				"TEST2_SAMPLE_COUNTER":  "one",
-				"TEST2_SAMPLE_FLOAT32":  "3.4e+39",
-				"TEST2_SAMPLE_FLOAT64":  "1.7e+308",
-				"TEST2_SAMPLE_DURATION": "15m",
-			},
 			expected: expected{
 				parsed:      []result{},
 				err:         true,
-				expectedErr: ferrors.ErrParseFailed,
+				expectedErr: ferrors.ErrInvalidValue,
 			},
+			input: []string{"--endpoint", "http://example.com"},
 		},
 		{
-			name: "env vars slices success",
+			name: "parse sizes and quantities",
 			flagSet: func() *FlagSet {
-				fs := New(env.Prefix("test1-slice"), env.Capitalized(), env.VarNameReplace("-", "_")).
-					BindFlag(flag.StringSlice("sample-string")).
-					BindFlag(flag.BoolSlice("sample-bool")).
-					BindFlag(flag.Float32Slice("sample-float32")).
-					BindFlag(flag.Float64Slice("sample-float64")).
-					BindFlag(flag.DurationSlice("sample-duration")).Build()
+				fs := New().
+					BindFlag(flag.ByteSize("cache-size")).
+					BindFlag(flag.ByteSizeSlice("buffers")).
+					BindFlag(flag.Quantity("max-requests")).
+					BindFlag(flag.QuantitySlice("limits")).
+					Build()
 				return fs
 			},
-			variables: map[string]string{
-				"TEST1_SLICE_SAMPLE_STRING":   "foo",
-				"TEST1_SLICE_SAMPLE_BOOL":     "true",
-				"TEST1_SLICE_SAMPLE_FLOAT32":  "3.14",
-				"TEST1_SLICE_SAMPLE_FLOAT64":  "3.14",
-				"TEST1_SLICE_SAMPLE_DURATION": "15m",
-			},
 			expected: expected{
 				parsed: []result{
-					{flagName: "sample-duration", flagValue: []time.Duration{time.Minute * 15}, flagType: "durationSlice"},
-					{flagName: "sample-string", flagValue: []string{"foo"}, flagType: "stringSlice"},
-					{flagName: "sample-float32", flagValue: []float32{3.14}, flagType: "float32slice"},
-					{flagName: "sample-float64", flagValue: []float64{3.14}, flagType: "float64slice"},
-					{flagName: "sample-bool", flagValue: []bool{true}, flagType: "boolSlice"},
+					{flagName: "cache-size", flagValue: 512 * flag.MiB, flagType: "byteSize"},
+					{flagName: "buffers", flagValue: []flag.ByteSizeValue{64 * flag.KB, 1500 * flag.MB}, flagType: "byteSizeSlice"},
+					{flagName: "max-requests", flagValue: flag.QuantityValue(10000), flagType: "quantity"},
+					{flagName: "limits", flagValue: []flag.QuantityValue{1000, 2000000}, flagType: "quantitySlice"},
 				},
 				err: false,
 			},
+			input: []string{
+				"--cache-size", "512MiB",
+				"--buffers", "64k,1.5G",
+				"--max-requests", "10k",
+				"--limits", "1k", "2M",
+			},
 		},
 		{
-			name: "env vars slices failed",
+			name: "parse time",
 			flagSet: func() *FlagSet {
-				fs := New(env.Prefix("test2-slice"), env.Capitalized(), env.VarNameReplace("-", "_")).
-					BindFlag(flag.BoolSlice("sample-bool")).
-					BindFlag(flag.Float32Slice("sample-float32")).
-					BindFlag(flag.Float64Slice("sample-float64")).
-					BindFlag(flag.DurationSlice("sample-duration")).Build()
+				fs := New().
+					BindFlag(flag.Time("since")).
+					BindFlag(flag.Time("until", flag.TimeLayouts(time.DateOnly))).
+					Build()
 				return fs
 			},
-			variables: map[string]string{
-				"TEST2_SLICE_SAMPLE_BOOL":     "yes",
-				"TEST2_SLICE_SAMPLE_FLOAT32":  "3.4e+39",
-				"TEST2_SLICE_SAMPLE_FLOAT64":  "1.7e+308",
-				"TEST2_SLICE_SAMPLE_DURATION": "15m",
+			expected: expected{
+				parsed: []result{
+					{flagName: "since", flagValue: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), flagType: "time"},
+					{flagName: "until", flagValue: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), flagType: "time"},
+				},
+				err: false,
+			},
+			input: []string{"--since", "2024-05-01T10:00:00Z", "--until", "2024-06-01"},
+		},
+		{
+			name: "custom flag no parser error",
+			flagSet: func() *FlagSet {
+				fs := New().BindFlag(flag.Typed[custom]("sample")).Build()
+				return fs
 			},
 			expected: expected{
 				parsed:      []result{},
 				err:         true,
-				expectedErr: ferrors.ErrParseFailed,
+				expectedErr: ferrors.ErrNoParserDefined,
 			},
+			input: []string{"--sample"},
 		},
 		{
-			name: "env vars signed integers success",
+			name: "custom flag no error",
 			flagSet: func() *FlagSet {
-				fs := New(env.Prefix("integers-success"), env.Capitalized(), env.VarNameReplace("-", "_")).
-					BindFlag(flag.Int("sample-int")).
-					BindFlag(flag.Int8("sample-int8")).
-					BindFlag(flag.Int16("sample-int16")).
-					BindFlag(flag.Int32("sample-int32")).
-					BindFlag(flag.Int64("sample-int64")).Build()
+				fs := New().
+					BindFlag(flag.Typed[custom]("sample", flag.Parser(&customParser{&parser.EmbeddedParser{}}))).
+					Build()
 				return fs
 			},
-			variables: map[string]string{
-				"INTEGERS_SUCCESS_SAMPLE_INT":   "42",
-				"INTEGERS_SUCCESS_SAMPLE_INT8":  "42",
-				"INTEGERS_SUCCESS_SAMPLE_INT16": "42",
-				"INTEGERS_SUCCESS_SAMPLE_INT32": "42",
-				"INTEGERS_SUCCESS_SAMPLE_INT64": "42",
-			},
 			expected: expected{
 				parsed: []result{
-					{flagName: "sample-int", flagValue: 42, flagType: "int"},
-					{flagName: "sample-int8", flagValue: int8(42), flagType: "int8"},
-					{flagName: "sample-int16", flagValue: int16(42), flagType: "int16"},
-					{flagName: "sample-int32", flagValue: int32(42), flagType: "int32"},
-					{flagName: "sample-int64", flagValue: int64(42), flagType: "int64"},
+					{flagName: "sample", flagValue: custom{field: 10}, flagType: "custom"},
 				},
 				err: false,
 			},
+			input: []string{"--sample", "10"},
 		},
 		{
-			name: "env vars signed integers failure",
+			name: "typed flag with text unmarshaler",
 			flagSet: func() *FlagSet {
-				fs := New(env.Prefix("integers-fail"), env.Capitalized(), env.VarNameReplace("-", "_")).
-					BindFlag(flag.Int("sample-int")).
-					BindFlag(flag.Int8("sample-int8")).
-					BindFlag(flag.Int16("sample-int16")).
-					BindFlag(flag.Int32("sample-int32")).
-					BindFlag(flag.Int64("sample-int64")).Build()
+				fs := New().
+					BindFlag(flag.Typed[slog.Level]("log-level")).
+					Build()
 				return fs
 			},
-			variables: map[string]string{
-				"INTEGERS_FAIL_SAMPLE_INT":   "42.",
-				"INTEGERS_FAIL_SAMPLE_INT8":  "1000",
-				"INTEGERS_FAIL_SAMPLE_INT16": "-32800",
-				"INTEGERS_FAIL_SAMPLE_INT32": "65656",
-				"INTEGERS_FAIL_SAMPLE_INT64": "9223372036854775809",
-			},
 			expected: expected{
-				parsed:      []result{},
-				err:         true,
-				expectedErr: ferrors.ErrParseFailed,
-			},
+				parsed: []result{
+					{flagName: "log-level", flagValue: slog.LevelDebug, flagType: "slogLevel"},
+				},
+				err: false,
+			},
+			input: []string{"--log-level", "debug"},
+		},
+		{
+			name: "custom flag parse error",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.Typed[custom]("sample", flag.Parser(&customParser{&parser.EmbeddedParser{}}))).
+					Build()
+				return fs
+			},
+			expected: expected{
+				parsed:      []result{},
+				err:         true,
+				expectedErr: ferrors.ErrParseFailed,
+			},
+			input: []string{"--sample", "invalid"},
+		},
+		{
+			name: "parse inline values",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.String("sample-string")).
+					BindFlag(flag.Bool("sample-bool", flag.Shorthand("b"))).
+					BindFlag(flag.StringMap("sample-map")).
+					BindFlag(flag.Int("sample-int", flag.Shorthand("i"))).Build()
+				return fs
+			},
+			expected: expected{
+				parsed: []result{
+					{flagName: "sample-string", flagValue: "foo=bar", flagType: "string"},
+					{flagName: "sample-bool", flagValue: false, flagType: "bool"},
+					{flagName: "sample-map", flagValue: map[string]string{"a": "1"}, flagType: "stringMap"},
+					{flagName: "sample-int", flagValue: 5, flagType: "int"},
+				},
+				err: false,
+			},
+			input: []string{"--sample-string=foo=bar", "-b=false", "--sample-map=a=1", "positional", "-i=5"},
+		},
+		{
+			name: "parse inline empty value",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.String("sample-string")).Build()
+				return fs
+			},
+			expected: expected{
+				parsed:      []result{},
+				err:         true,
+				expectedErr: ferrors.ErrNoValueProvided,
+			},
+			input: []string{"--sample-string=", "foo"},
+		},
+		{
+			name: "parse go mode",
+			flagSet: func() *FlagSet {
+				fs := New().
+					ParseMode(ParseModeGo).
+					BindFlag(flag.String("name")).
+					BindFlag(flag.Bool("verbose", flag.Shorthand("v"))).
+					BindFlag(flag.Int("level", flag.Shorthand("l"))).
+					BindFlag(flag.Duration("timeout")).Build()
+				return fs
+			},
+			expected: expected{
+				parsed: []result{
+					{flagName: "name", flagValue: "foo", flagType: "string"},
+					{flagName: "verbose", flagValue: true, flagType: "bool"},
+					{flagName: "level", flagValue: 3, flagType: "int"},
+					{flagName: "timeout", flagValue: time.Minute, flagType: "duration"},
+				},
+				err: false,
+			},
+			input: []string{"-name", "foo", "-v", "-l=3", "--timeout", "1m"},
+		},
+		{
+			name: "parse go mode no stacking",
+			flagSet: func() *FlagSet {
+				fs := New().
+					ParseMode(ParseModeGo).
+					BindFlag(flag.Bool("a", flag.Shorthand("a"))).
+					BindFlag(flag.Bool("b", flag.Shorthand("b"))).Build()
+				return fs
+			},
+			expected: expected{
+				parsed:      []result{},
+				err:         true,
+				expectedErr: ferrors.ErrUnknownFlag,
+			},
+			input: []string{"-ab"},
+		},
+		{
+			name: "parse case-sensitive shorthands",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.Bool("verbose", flag.Shorthand("v"))).
+					BindFlag(flag.Bool("version", flag.Shorthand("V"))).
+					BindFlag(flag.String("name")).
+					BindFlag(flag.String("Name")).Build()
+				return fs
+			},
+			expected: expected{
+				parsed: []result{
+					{flagName: "version", flagValue: true, flagType: "bool"},
+					{flagName: "Name", flagValue: "foo", flagType: "string"},
+				},
+				err: false,
+			},
+			input: []string{"-V", "--Name", "foo"},
+		},
+		{
+			name: "parse case-sensitive names",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.Bool("verbose")).Build()
+				return fs
+			},
+			expected: expected{
+				parsed:      []result{},
+				err:         true,
+				expectedErr: ferrors.ErrUnknownFlag,
+			},
+			input: []string{"--Verbose"},
+		},
+		{
+			name: "parse case-insensitive names",
+			flagSet: func() *FlagSet {
+				fs := New().
+					IgnoreCase().
+					BindFlag(flag.Bool("verbose", flag.Shorthand("v"))).
+					BindFlag(flag.Bool("version", flag.Shorthand("V"))).Build()
+				return fs
+			},
+			expected: expected{
+				parsed: []result{
+					{flagName: "verbose", flagValue: true, flagType: "bool"},
+					{flagName: "version", flagValue: true, flagType: "bool"},
+				},
+				err: false,
+			},
+			input: []string{"--VERBOSE", "-V"},
+		},
+		{
+			name: "parse negative numbers",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.Int("offset")).
+					BindFlag(flag.Float64("ratio", flag.Shorthand("r"))).
+					BindFlag(flag.IntSlice("deltas")).
+					BindFlag(flag.Bool("verbose", flag.Shorthand("v"))).Build()
+				return fs
+			},
+			expected: expected{
+				parsed: []result{
+					{flagName: "offset", flagValue: -5, flagType: "int"},
+					{flagName: "ratio", flagValue: -0.5, flagType: "float64"},
+					{flagName: "deltas", flagValue: []int{-1, 2, -3}, flagType: "intSlice"},
+					{flagName: "verbose", flagValue: true, flagType: "bool"},
+				},
+				err: false,
+			},
+			input: []string{"--offset", "-5", "-r", "-0.5", "--deltas", "-1", "2", "-3", "-v"},
+		},
+		{
+			name: "parse negative numbers with digit shorthand",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.Int("offset")).
+					BindFlag(flag.Bool("ipv4", flag.Shorthand("4"))).Build()
+				return fs
+			},
+			expected: expected{
+				parsed:      []result{},
+				err:         true,
+				expectedErr: ferrors.ErrNoValueProvided,
+			},
+			input: []string{"--offset", "-5"},
+		},
+		{
+			name: "parse dash-prefixed value",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.String("pattern", flag.ConsumeNextArg())).
+					BindFlag(flag.Int("offset", flag.ConsumeNextArg())).
+					BindFlag(flag.Bool("ipv4", flag.Shorthand("4"))).
+					BindFlag(flag.Bool("verbose")).Build()
+				return fs
+			},
+			expected: expected{
+				parsed: []result{
+					{flagName: "pattern", flagValue: "-foo", flagType: "string"},
+					{flagName: "offset", flagValue: -5, flagType: "int"},
+					{flagName: "verbose", flagValue: true, flagType: "bool"},
+				},
+				err: false,
+			},
+			input: []string{"--pattern", "-foo", "--offset", "-5", "positional", "--verbose"},
+		},
+		{
+			name: "parse abbreviations",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.Bool("verbose")).
+					BindFlag(flag.Bool("version")).
+					BindFlag(flag.String("name")).
+					BindFlag(flag.String("name-prefix")).Build()
+				return fs
+			},
+			expected: expected{
+				parsed: []result{
+					{flagName: "verbose", flagValue: true, flagType: "bool"},
+					{flagName: "version", flagValue: true, flagType: "bool"},
+					{flagName: "name", flagValue: "foo", flagType: "string"},
+					{flagName: "name-prefix", flagValue: "bar", flagType: "string"},
+				},
+				err: false,
+			},
+			input: []string{"--verb", "--versi", "--name", "foo", "--name-p=bar"},
+		},
+		{
+			name: "parse ambiguous abbreviation",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.Bool("verbose")).
+					BindFlag(flag.Bool("version")).Build()
+				return fs
+			},
+			expected: expected{
+				parsed:      []result{},
+				err:         true,
+				expectedErr: ferrors.ErrAmbiguousFlag,
+			},
+			input: []string{"--ver"},
+		},
+		{
+			name: "parse abbreviations disabled",
+			flagSet: func() *FlagSet {
+				fs := New().
+					DisableAbbreviations().
+					BindFlag(flag.Bool("verbose")).Build()
+				return fs
+			},
+			expected: expected{
+				parsed:      []result{},
+				err:         true,
+				expectedErr: ferrors.ErrUnknownFlag,
+			},
+			input: []string{"--verb"},
+		},
+		{
+			name: "parse negated flags",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.Bool("color", flag.DefaultValue(true))).
+					BindFlag(flag.Bool("verbose")).
+					BindFlag(flag.TriBool("cache")).
+					BindFlag(flag.TriBool("strict")).
+					BindFlag(flag.TriBool("debug")).Build()
+				return fs
+			},
+			expected: expected{
+				parsed: []result{
+					{flagName: "color", flagValue: false, flagType: "bool"},
+					{flagName: "verbose", flagValue: true, flagType: "bool"},
+					{flagName: "cache", flagValue: flag.TriFalse, flagType: "triBool"},
+					{flagName: "strict", flagValue: flag.TriTrue, flagType: "triBool"},
+					{flagName: "debug", flagValue: flag.TriUnset, flagType: "triBool"},
+				},
+				err: false,
+			},
+			input: []string{"--no-color", "--verbose", "--no-cache", "--strict"},
+		},
+		{
+			name: "parse negated abbreviation",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.Bool("verbose", flag.DefaultValue(true))).Build()
+				return fs
+			},
+			expected: expected{
+				parsed: []result{
+					{flagName: "verbose", flagValue: false, flagType: "bool"},
+				},
+				err: false,
+			},
+			input: []string{"--no-verb"},
+		},
+		{
+			name: "parse negation disabled",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.Bool("color", flag.NoNegation())).Build()
+				return fs
+			},
+			expected: expected{
+				parsed:      []result{},
+				err:         true,
+				expectedErr: ferrors.ErrUnknownFlag,
+			},
+			input: []string{"--no-color"},
+		},
+		{
+			name: "parse negated non-bool",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.String("color")).Build()
+				return fs
+			},
+			expected: expected{
+				parsed:      []result{},
+				err:         true,
+				expectedErr: ferrors.ErrUnknownFlag,
+			},
+			input: []string{"--no-color"},
+		},
+		{
+			name: "parse negated with value",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.Bool("color")).Build()
+				return fs
+			},
+			expected: expected{
+				parsed:      []result{},
+				err:         true,
+				expectedErr: ferrors.ErrInvalidValue,
+			},
+			input: []string{"--no-color=true"},
+		},
+		{
+			name: "parse optional values",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.Choice("color", []string{"auto", "always", "never"}, flag.NoArgValue("auto"))).
+					BindFlag(flag.Choice("paging", []string{"auto", "always", "never"}, flag.NoArgValue("auto"))).
+					BindFlag(flag.Int("level", flag.Shorthand("l"), flag.NoArgValue("1"))).
+					BindFlag(flag.Bool("verbose", flag.Shorthand("v"))).Build()
+				return fs
+			},
+			expected: expected{
+				parsed: []result{
+					{flagName: "color", flagValue: "auto", flagType: "string"},
+					{flagName: "paging", flagValue: "never", flagType: "string"},
+					{flagName: "level", flagValue: 1, flagType: "int"},
+					{flagName: "verbose", flagValue: true, flagType: "bool"},
+				},
+				err: false,
+			},
+			input: []string{"--color", "positional", "--paging=never", "-lv"},
+		},
+		{
+			name: "parse repeated default policy",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.IntSlice("ints")).
+					BindFlag(flag.Counter("verbose", flag.Shorthand("v"))).Build()
+				return fs
+			},
+			expected: expected{
+				parsed: []result{
+					{flagName: "ints", flagValue: []int{1, 2}, flagType: "intSlice"},
+					{flagName: "verbose", flagValue: 2, flagType: "counter"},
+				},
+				err: false,
+			},
+			input: []string{"--ints", "1", "-v", "--ints", "2", "-v"},
+		},
+		{
+			name: "parse repeated last wins",
+			flagSet: func() *FlagSet {
+				fs := New().
+					RepeatPolicy(flag.RepeatLastWins).
+					BindFlag(flag.String("name")).
+					BindFlag(flag.Bool("verbose")).
+					BindFlag(flag.IntSlice("ints")).
+					BindFlag(flag.IntSlice("ports", flag.Repeat(flag.RepeatAppend))).Build()
+				return fs
+			},
+			expected: expected{
+				parsed: []result{
+					{flagName: "name", flagValue: "bar", flagType: "string"},
+					{flagName: "verbose", flagValue: false, flagType: "bool"},
+					{flagName: "ints", flagValue: []int{3}, flagType: "intSlice"},
+					{flagName: "ports", flagValue: []int{80, 443}, flagType: "intSlice"},
+				},
+				err: false,
+			},
+			input: []string{"--name", "foo", "--verbose", "--ints", "1", "2", "--name", "bar", "--no-verbose", "--ints", "3", "--ports", "80", "--ports", "443"},
+		},
+		{
+			name: "parse repeated first wins",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.String("name", flag.Repeat(flag.RepeatFirstWins))).
+					BindFlag(flag.StringSlice("tags", flag.Repeat(flag.RepeatFirstWins))).Build()
+				return fs
+			},
+			expected: expected{
+				parsed: []result{
+					{flagName: "name", flagValue: "foo", flagType: "string"},
+					{flagName: "tags", flagValue: []string{"a"}, flagType: "stringSlice"},
+				},
+				err: false,
+			},
+			input: []string{"--name", "foo", "--tags", "a", "--name", "bar", "--tags", "b"},
+		},
+		{
+			name: "parse repeated error",
+			flagSet: func() *FlagSet {
+				fs := New().
+					RepeatPolicy(flag.RepeatError).
+					BindFlag(flag.StringSlice("tags")).Build()
+				return fs
+			},
+			expected: expected{
+				parsed:      []result{},
+				err:         true,
+				expectedErr: ferrors.ErrFlagVisited,
+			},
+			input: []string{"--tags", "a", "--tags", "b"},
+		},
+		{
+			name: "parse repeated append scalar",
+			flagSet: func() *FlagSet {
+				fs := New().
+					RepeatPolicy(flag.RepeatAppend).
+					BindFlag(flag.Int("port")).Build()
+				return fs
+			},
+			expected: expected{
+				parsed:      []result{},
+				err:         true,
+				expectedErr: ferrors.ErrFlagVisited,
+			},
+			input: []string{"--port", "80", "--port", "443"},
+		},
+		{
+			name: "parse aliases",
+			flagSet: func() *FlagSet {
+				fs := New().
+					DeprecationWriter(io.Discard).
+					BindFlag(flag.String("bind-address", flag.Aliases("listen"), flag.DeprecatedAliases("bind-addr"))).
+					BindFlag(flag.Bool("verbose", flag.Aliases("chatty"))).
+					BindFlag(flag.Int("port", flag.Aliases("listen-port"))).Build()
+				return fs
+			},
+			expected: expected{
+				parsed: []result{
+					{flagName: "bind-address", flagValue: "localhost", flagType: "string"},
+					{flagName: "verbose", flagValue: true, flagType: "bool"},
+					{flagName: "port", flagValue: 80, flagType: "int"},
+				},
+				err: false,
+			},
+			input: []string{"--listen", "localhost", "--chat", "--listen-p=80"},
+		},
+		{
+			name: "parse deprecated alias is not abbreviated",
+			flagSet: func() *FlagSet {
+				fs := New().
+					DeprecationWriter(io.Discard).
+					BindFlag(flag.String("address", flag.DeprecatedAliases("bind-addr"))).Build()
+				return fs
+			},
+			expected: expected{
+				parsed:      []result{},
+				err:         true,
+				expectedErr: ferrors.ErrUnknownFlag,
+			},
+			input: []string{"--bind", "localhost"},
+		},
+		{
+			name: "context parser with lookup",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.Int("min")).
+					BindFlag(flag.Typed[int]("max", flag.ContextParser(notLessThanMin))).
+					Build()
+				return fs
+			},
+			expected: expected{
+				parsed: []result{
+					{flagName: "min", flagValue: 2, flagType: "int"},
+					{flagName: "max", flagValue: 5, flagType: "int"},
+				},
+				err: false,
+			},
+			input: []string{"--min", "2", "--max", "5"},
+		},
+		{
+			name: "context parser with lookup error",
+			flagSet: func() *FlagSet {
+				fs := New().
+					BindFlag(flag.Int("min")).
+					BindFlag(flag.Typed[int]("max", flag.ContextParser(notLessThanMin))).
+					Build()
+				return fs
+			},
+			expected: expected{
+				parsed:      []result{},
+				err:         true,
+				expectedErr: ferrors.ErrInvalidValue,
+			},
+			input: []string{"--min", "7", "--max", "5"},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			fs := tt.flagSet()
+			err := fs.Parse(tt.input)
+			if tt.expected.err {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expected.expectedErr))
+				return
+			}
+			assert.NoError(t, err)
+			for _, r := range tt.expected.parsed {
+				require.NotNil(t, fs.flagByExactName(r.flagName).Value())
+				assertParsedValue(t, fs, r)
+			}
+		})
+	}
+}
+
+type envTestCase struct {
+	name      string
+	flagSet   func() *FlagSet
+	variables map[string]string
+	expected  expected
+}
+
+func TestFlagSet_BindEnvVars(t *testing.T) {
+	t.Parallel()
+
+	tests := []envTestCase{
+		{
+			name: "env vars success",
+			flagSet: func() *FlagSet {
+				fs := New(env.Prefix("test1"), env.Capitalized(), env.VarNameReplace("-", "_")).
+					BindFlag(flag.String("sample-string")).
+					BindFlag(flag.Bool("sample-bool")).
+					BindFlag(flag.Counter("sample-counter")).
+					BindFlag(flag.Float32("sample-float32")).
+					BindFlag(flag.Float64("sample-float64")).
+					BindFlag(flag.Duration("sample-duration")).Build()
+				return fs
+			},
+			variables: map[string]string{
+				"TEST1_SAMPLE_STRING":   "foo",
+				"TEST1_SAMPLE_BOOL":     "true",
+				"TEST1_SAMPLE_COUNTER":  "1",
+				"TEST1_SAMPLE_FLOAT32":  "3.14",
+				"TEST1_SAMPLE_FLOAT64":  "3.14",
+				"TEST1_SAMPLE_DURATION": "15m",
+			},
+			expected: expected{
+				parsed: []result{
+					{flagName: "sample-duration", flagValue: time.Minute * 15, flagType: "duration"},
+					{flagName: "sample-string", flagValue: "foo", flagType: "string"},
+					{flagName: "sample-counter", flagValue: 1, flagType: "counter"},
+					{flagName: "sample-float32", flagValue: float32(3.14), flagType: "float32"},
+					{flagName: "sample-float64", flagValue: 3.14, flagType: "float64"},
+					{flagName: "sample-bool", flagValue: true, flagType: "bool"},
+				},
+				err: false,
+			},
+		},
+		{
+			name: "env vars failed",
+			flagSet: func() *FlagSet {
+				fs := New(env.Prefix("test2"), env.Capitalized(), env.VarNameReplace("-", "_")).
+					BindFlag(flag.String("sample-string")).
+					BindFlag(flag.Bool("sample-bool")).
+					BindFlag(flag.Counter("sample-counter")).
+					BindFlag(flag.Float32("sample-float32")).
+					BindFlag(flag.Float64("sample-float64")).
+					BindFlag(flag.Duration("sample-duration")).Build()
+				return fs
+			},
+			variables: map[string]string{
+				"TEST2_SAMPLE_STRING":   "foo",
+				"TEST2_SAMPLE_BOOL":     "yes",
+				"TEST2_SAMPLE_COUNTER":  "one",
+				"TEST2_SAMPLE_FLOAT32":  "3.4e+39",
+				"TEST2_SAMPLE_FLOAT64":  "1.7e+308",
+				"TEST2_SAMPLE_DURATION": "15m",
+			},
+			expected: expected{
+				parsed:      []result{},
+				err:         true,
+				expectedErr: ferrors.ErrParseFailed,
+			},
+		},
+		{
+			name: "env vars slices success",
+			flagSet: func() *FlagSet {
+				fs := New(env.Prefix("test1-slice"), env.Capitalized(), env.VarNameReplace("-", "_")).
+					BindFlag(flag.StringSlice("sample-string")).
+					BindFlag(flag.BoolSlice("sample-bool")).
+					BindFlag(flag.Float32Slice("sample-float32")).
+					BindFlag(flag.Float64Slice("sample-float64")).
+					BindFlag(flag.DurationSlice("sample-duration")).Build()
+				return fs
+			},
+			variables: map[string]string{
+				"TEST1_SLICE_SAMPLE_STRING":   "foo",
+				"TEST1_SLICE_SAMPLE_BOOL":     "true",
+				"TEST1_SLICE_SAMPLE_FLOAT32":  "3.14",
+				"TEST1_SLICE_SAMPLE_FLOAT64":  "3.14",
+				"TEST1_SLICE_SAMPLE_DURATION": "15m",
+			},
+			expected: expected{
+				parsed: []result{
+					{flagName: "sample-duration", flagValue: []time.Duration{time.Minute * 15}, flagType: "durationSlice"},
+					{flagName: "sample-string", flagValue: []string{"foo"}, flagType: "stringSlice"},
+					{flagName: "sample-float32", flagValue: []float32{3.14}, flagType: "float32slice"},
+					{flagName: "sample-float64", flagValue: []float64{3.14}, flagType: "float64slice"},
+					{flagName: "sample-bool", flagValue: []bool{true}, flagType: "boolSlice"},
+				},
+				err: false,
+			},
+		},
+		{
+			name: "env vars slices failed",
+			flagSet: func() *FlagSet {
+				fs := New(env.Prefix("test2-slice"), env.Capitalized(), env.VarNameReplace("-", "_")).
+					BindFlag(flag.BoolSlice("sample-bool")).
+					BindFlag(flag.Float32Slice("sample-float32")).
+					BindFlag(flag.Float64Slice("sample-float64")).
+					BindFlag(flag.DurationSlice("sample-duration")).Build()
+				return fs
+			},
+			variables: map[string]string{
+				"TEST2_SLICE_SAMPLE_BOOL":     "yes",
+				"TEST2_SLICE_SAMPLE_FLOAT32":  "3.4e+39",
+				"TEST2_SLICE_SAMPLE_FLOAT64":  "1.7e+308",
+				"TEST2_SLICE_SAMPLE_DURATION": "15m",
+			},
+			expected: expected{
+				parsed:      []result{},
+				err:         true,
+				expectedErr: ferrors.ErrParseFailed,
+			},
+		},
+		{
+			name: "env vars maps success",
+			flagSet: func() *FlagSet {
+				fs := New(env.Prefix("maps-success"), env.Capitalized(), env.VarNameReplace("-", "_")).
+					BindFlag(flag.StringMap("sample-labels")).
+					BindFlag(flag.IntMap("sample-limits")).Build()
+				return fs
+			},
+			variables: map[string]string{
+				"MAPS_SUCCESS_SAMPLE_LABELS": "env=prod,tier=web",
+				"MAPS_SUCCESS_SAMPLE_LIMITS": "cpu=2",
+			},
+			expected: expected{
+				parsed: []result{
+					{flagName: "sample-labels", flagValue: map[string]string{"env": "prod", "tier": "web"}, flagType: "stringMap"},
+					{flagName: "sample-limits", flagValue: map[string]int{"cpu": 2}, flagType: "intMap"},
+				},
+				err: false,
+			},
+		},
+		{
+			name: "env vars signed integers success",
+			flagSet: func() *FlagSet {
+				fs := New(env.Prefix("integers-success"), env.Capitalized(), env.VarNameReplace("-", "_")).
+					BindFlag(flag.Int("sample-int")).
+					BindFlag(flag.Int8("sample-int8")).
+					BindFlag(flag.Int16("sample-int16")).
+					BindFlag(flag.Int32("sample-int32")).
+					BindFlag(flag.Int64("sample-int64")).Build()
+				return fs
+			},
+			variables: map[string]string{
+				"INTEGERS_SUCCESS_SAMPLE_INT":   "42",
+				"INTEGERS_SUCCESS_SAMPLE_INT8":  "42",
+				"INTEGERS_SUCCESS_SAMPLE_INT16": "42",
+				"INTEGERS_SUCCESS_SAMPLE_INT32": "42",
+				"INTEGERS_SUCCESS_SAMPLE_INT64": "42",
+			},
+			expected: expected{
+				parsed: []result{
+					{flagName: "sample-int", flagValue: 42, flagType: "int"},
+					{flagName: "sample-int8", flagValue: int8(42), flagType: "int8"},
+					{flagName: "sample-int16", flagValue: int16(42), flagType: "int16"},
+					{flagName: "sample-int32", flagValue: int32(42), flagType: "int32"},
+					{flagName: "sample-int64", flagValue: int64(42), flagType: "int64"},
+				},
+				err: false,
+			},
+		},
+		{
+			name: "env vars signed integers failure",
+			flagSet: func() *FlagSet {
+				fs := New(env.Prefix("integers-fail"), env.Capitalized(), env.VarNameReplace("-", "_")).
+					BindFlag(flag.Int("sample-int")).
+					BindFlag(flag.Int8("sample-int8")).
+					BindFlag(flag.Int16("sample-int16")).
+					BindFlag(flag.Int32("sample-int32")).
+					BindFlag(flag.Int64("sample-int64")).Build()
+				return fs
+			},
+			variables: map[string]string{
+				"INTEGERS_FAIL_SAMPLE_INT":   "42.",
+				"INTEGERS_FAIL_SAMPLE_INT8":  "1000",
+				"INTEGERS_FAIL_SAMPLE_INT16": "-32800",
+				"INTEGERS_FAIL_SAMPLE_INT32": "65656",
+				"INTEGERS_FAIL_SAMPLE_INT64": "9223372036854775809",
+			},
+			expected: expected{
+				parsed:      []result{},
+				err:         true,
+				expectedErr: ferrors.ErrParseFailed,
+			},
 		},
 		{
 			name: "env vars unsigned integers success",
@@ -1017,9 +1831,799 @@ func TestFlagSet_BindEnvVars(t *testing.T) {
 			}
 			require.NoError(t, err)
 			for _, r := range tt.expected.parsed {
-				require.NotNil(t, fs.flagByName(r.flagName).Value())
+				require.NotNil(t, fs.flagByExactName(r.flagName).Value())
 				assertParsedValue(t, fs, r)
 			}
 		})
 	}
 }
+
+func TestFlagSet_ParseContext(t *testing.T) {
+	t.Parallel()
+	var contexts []parser.Context
+	record := parser.ContextParserFunc(func(ctx parser.Context, s string) (any, error) {
+		contexts = append(contexts, ctx)
+		return &s, nil
+	})
+	fs := New(env.Prefix("context"), env.Capitalized(), env.VarNameReplace("-", "_")).
+		BindFlag(flag.Typed[string]("sample", flag.ContextParser(record), flag.Separator(";"))).
+		Build()
+	require.NoError(t, os.Setenv("CONTEXT_SAMPLE", "env"))
+	defer func() {
+		require.NoError(t, os.Unsetenv("CONTEXT_SAMPLE"))
+	}()
+	require.NoError(t, fs.BindEnvVars())
+	require.NoError(t, fs.Parse([]string{"positional", "--sample", "cmd"}))
+	require.Len(t, contexts, 2)
+
+	assert.Equal(t, parser.SourceEnvironment, contexts[0].Source)
+	assert.Equal(t, "CONTEXT_SAMPLE", contexts[0].EnvVar)
+	assert.Equal(t, -1, contexts[0].ArgIndex)
+
+	assert.Equal(t, "sample", contexts[1].FlagName)
+	assert.Equal(t, parser.SourceCommandLine, contexts[1].Source)
+	assert.Equal(t, 1, contexts[1].ArgIndex)
+	assert.Equal(t, ";", contexts[1].Separator)
+	assert.True(t, contexts[1].IsSetFromEnv)
+	assert.Equal(t, ptrTo("env"), contexts[1].CurrentValue)
+	v, ok := contexts[1].Lookup("sample")
+	assert.True(t, ok)
+	assert.Equal(t, ptrTo("cmd"), v)
+	_, ok = contexts[1].Lookup("missing")
+	assert.False(t, ok)
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
+
+func TestFlagSet_AmbiguousFlag(t *testing.T) {
+	t.Parallel()
+	fs := New().
+		BindFlag(flag.Bool("verbose")).
+		BindFlag(flag.Bool("version")).
+		BindFlag(flag.Bool("quiet")).
+		Build()
+	err := fs.Parse([]string{"--ver"})
+	require.Error(t, err)
+	assert.EqualError(t, err, "ver: ambiguous flag, candidates are: verbose, version")
+}
+
+func TestIsNegativeValue(t *testing.T) {
+	t.Parallel()
+	for _, arg := range []string{"-5", "-1.5", "-1.", "-.5", "-1e3", "-2.5E-3", "-1.5M", "-5s", "-0x1p3"} {
+		assert.True(t, isNegativeValue(arg), arg)
+	}
+	for _, arg := range []string{"5", "-", "-.", "-e3", "-inf", "-Inf", "-nan", "-Infinity", "-v", "--5"} {
+		assert.False(t, isNegativeValue(arg), arg)
+	}
+	fs := New().BindFlag(flag.Float64("offset")).Build()
+	assert.ErrorIs(t, fs.Parse([]string{"--offset", "1", "-inf"}), ferrors.ErrUnknownShorthand)
+}
+
+func TestFlagSet_NegativeValues(t *testing.T) {
+	t.Parallel()
+	newFlagSet := func() *FlagSet {
+		return New().
+			BindFlag(flag.Counter("verbose", flag.Shorthand("v"))).
+			BindFlag(flag.TriBool("tri")).
+			BindFlag(flag.Quantity("cpu")).
+			BindFlag(flag.Duration("timeout")).
+			BindFlag(flag.Int64Slice("offsets")).
+			BindFlag(flag.Uint("workers")).
+			Build()
+	}
+	fs := newFlagSet()
+	require.NoError(t, fs.Parse([]string{"--cpu", "-1.5M", "--timeout", "-5s", "--offsets", "-1", "-2"}))
+	assert.Equal(t, flag.QuantityValue(-1_500_000), GetQuantity(fs, "cpu"))
+	assert.Equal(t, -5*time.Second, GetDuration(fs, "timeout"))
+
+	fs = newFlagSet()
+	assert.ErrorIs(t, fs.Parse([]string{"-v", "-1"}), ferrors.ErrUnknownShorthand)
+	assert.Equal(t, 1, GetCounter(fs, "verbose"))
+
+	fs = newFlagSet()
+	assert.ErrorIs(t, fs.Parse([]string{"--tri", "-1"}), ferrors.ErrUnknownShorthand)
+	assert.Equal(t, flag.TriTrue, GetTriBool(fs, "tri"))
+
+	fs = newFlagSet()
+	assert.ErrorIs(t, fs.Parse([]string{"--workers", "4", "-1"}), ferrors.ErrUnknownShorthand)
+	assert.Equal(t, uint(4), GetUint(fs, "workers"))
+
+	parseRatio := func(s string) (float64, error) { return strconv.ParseFloat(s, 64) }
+	fs = New().
+		BindFlag(flag.Typed[float64]("ratio", flag.Parser(parser.Signed(parser.Func(parseRatio))))).
+		Build()
+	require.NoError(t, fs.Parse([]string{"--ratio", "-0.5"}))
+	assert.InDelta(t, -0.5, GetTypedFlag[float64](fs, "ratio"), 0)
+
+	fs = New().
+		BindFlag(flag.Duration("timeout")).
+		BindFlag(flag.Bool("one", flag.Shorthand("1"))).
+		Build()
+	require.NoError(t, fs.Parse([]string{"--timeout", "5s", "-1"}))
+	assert.Equal(t, 5*time.Second, GetDuration(fs, "timeout"))
+	assert.True(t, GetBool(fs, "one"))
+}
+
+func TestFlagSet_ParseModeGoPositionals(t *testing.T) {
+	t.Parallel()
+	newFlagSet := func() *FlagSet {
+		return New().
+			ParseMode(ParseModeGo).
+			BindFlag(flag.Int("n")).
+			BindFlag(flag.String("name")).
+			BindFlag(flag.Bool("verbose")).
+			BindFlag(flag.Counter("v")).
+			BindFlag(flag.TriBool("cache")).
+			BindFlag(flag.Int("offset")).
+			Build()
+	}
+	fs := newFlagSet()
+	require.NoError(t, fs.Parse([]string{"-n", "3", "input.txt"}))
+	assert.Equal(t, 3, GetInt(fs, "n"))
+
+	fs = newFlagSet()
+	require.NoError(t, fs.Parse([]string{"-name", "x", "input.txt", "output.txt"}))
+	assert.Equal(t, "x", GetString(fs, "name"))
+
+	fs = newFlagSet()
+	require.NoError(t, fs.Parse([]string{"-verbose", "input.txt", "-v", "-v", "input.txt", "-cache", "input.txt"}))
+	assert.True(t, GetBool(fs, "verbose"))
+	assert.Equal(t, 2, GetCounter(fs, "v"))
+	assert.Equal(t, flag.TriTrue, GetTriBool(fs, "cache"))
+
+	fs = newFlagSet()
+	require.NoError(t, fs.Parse([]string{"-verbose=false", "-offset", "-5", "-name", "-x", "input.txt"}))
+	assert.False(t, GetBool(fs, "verbose"))
+	assert.Equal(t, -5, GetInt(fs, "offset"))
+	assert.Equal(t, "-x", GetString(fs, "name"))
+
+	fs = newFlagSet()
+	assert.ErrorIs(t, fs.Parse([]string{"input.txt", "-n"}), ferrors.ErrNoValueProvided)
+}
+
+func TestFlagSet_GettersDoNotExpandAbbreviations(t *testing.T) {
+	t.Parallel()
+	fs := New().
+		BindFlag(flag.Int("port-range")).
+		Build()
+	require.NoError(t, fs.Parse([]string{"--port", "5"}))
+	assert.Equal(t, 5, GetInt(fs, "port-range"))
+	assert.Nil(t, fs.Lookup("port"))
+	assert.Panics(t, func() { GetInt(fs, "port") })
+}
+
+func TestFlagSet_UnknownFlagSuggestions(t *testing.T) {
+	t.Parallel()
+	fs := New().
+		DisableAbbreviations().
+		BindFlag(flag.Uint16("bind-port")).
+		BindFlag(flag.String("bind-address")).
+		BindFlag(flag.Bool("verbose", flag.Shorthand("v"))).
+		BindFlag(flag.Bool("quiet", flag.Shorthand("q"))).
+		Build()
+	tests := []struct {
+		input       []string
+		suggestions []string
+		shorthand   bool
+		message     string
+	}{
+		{[]string{"--bind-prot", "80"}, []string{"bind-port"}, false, "bind-prot: unknown flag, did you mean --bind-port?"},
+		{[]string{"--bind"}, []string{"bind-port", "bind-address"}, false, ""},
+		{[]string{"--verbose-mode"}, []string{"verbose"}, false, ""},
+		{[]string{"--timeout"}, nil, false, "timeout: unknown flag"},
+		{[]string{"-V"}, []string{"v"}, true, "V: unknown shorthand, did you mean -v?"},
+		{[]string{"-qx"}, nil, true, ""},
+	}
+	for _, tt := range tests {
+		err := fs.Parse(tt.input)
+		var unknown *ferrors.UnknownFlagError
+		require.ErrorAs(t, err, &unknown)
+		assert.Equal(t, tt.shorthand, unknown.Shorthand)
+		assert.Equal(t, tt.suggestions, unknown.Suggestions, tt.input)
+		if tt.message != "" {
+			assert.EqualError(t, err, tt.message)
+		}
+	}
+	assert.ErrorIs(t, fs.Parse([]string{"--bind"}), ferrors.ErrUnknownFlag)
+	assert.ErrorIs(t, fs.Parse([]string{"-V"}), ferrors.ErrUnknownShorthand)
+
+	short := New().
+		DisableAbbreviations().
+		BindFlag(flag.Bool("v")).
+		BindFlag(flag.Bool("db")).
+		BindFlag(flag.String("log-a")).
+		BindFlag(flag.String("log-b")).
+		BindFlag(flag.String("log-c")).
+		BindFlag(flag.String("log-d")).
+		Build()
+	var unknown *ferrors.UnknownFlagError
+	require.ErrorAs(t, short.Parse([]string{"--version-info"}), &unknown)
+	assert.Empty(t, unknown.Suggestions)
+	require.ErrorAs(t, short.Parse([]string{"--dbname"}), &unknown)
+	assert.Empty(t, unknown.Suggestions)
+	require.ErrorAs(t, short.Parse([]string{"--log"}), &unknown)
+	assert.Equal(t, []string{"log-a", "log-b", "log-c"}, unknown.Suggestions)
+	for _, input := range []string{"--", "--=x", "-="} {
+		require.ErrorAs(t, short.Parse([]string{input}), &unknown, input)
+		assert.Empty(t, unknown.Suggestions, input)
+	}
+
+	goStyle := New().
+		ParseMode(ParseModeGo).
+		BindFlag(flag.Uint16("bind-port")).
+		Build()
+	assert.EqualError(t, goStyle.Parse([]string{"-bind-prot"}), "bind-prot: unknown flag, did you mean -bind-port?")
+}
+
+func TestFlagSet_DeprecatedAliases(t *testing.T) {
+	t.Parallel()
+	var deprecated []string
+	fs := New().
+		OnDeprecated(func(alias, name string) { deprecated = append(deprecated, alias+"->"+name) }).
+		BindFlag(flag.String("bind-address", flag.DeprecatedAliases("bind-addr", "addr"))).
+		BindFlag(flag.Bool("color", flag.DeprecatedAliases("colour"))).
+		Build()
+	require.NoError(t, fs.Parse([]string{"--bind-addr", "localhost", "--no-colour"}))
+	assert.Equal(t, "localhost", GetString(fs, "bind-address"))
+	assert.False(t, GetBool(fs, "color"))
+	assert.Equal(t, []string{"bind-addr->bind-address", "colour->color"}, deprecated)
+
+	var buf bytes.Buffer
+	fs = New().
+		DeprecationWriter(&buf).
+		BindFlag(flag.String("bind-address", flag.DeprecatedAliases("bind-addr"))).
+		Build()
+	require.NoError(t, fs.Parse([]string{"--bind-addr=localhost"}))
+	assert.Equal(t, "Warning: flag --bind-addr is deprecated, use --bind-address instead\n", buf.String())
+	buf.Reset()
+	require.NoError(t, New().
+		DeprecationWriter(&buf).
+		BindFlag(flag.String("bind-address", flag.DeprecatedAliases("bind-addr"))).
+		Build().Parse([]string{"--bind-address", "localhost"}))
+	assert.Empty(t, buf.String())
+	require.NoError(t, New().
+		DeprecationWriter(&buf).
+		ParseMode(ParseModeGo).
+		BindFlag(flag.String("bind-address", flag.DeprecatedAliases("bind-addr"))).
+		Build().Parse([]string{"-bind-addr", "localhost"}))
+	assert.Equal(t, "Warning: flag -bind-addr is deprecated, use -bind-address instead\n", buf.String())
+}
+
+func TestFlagSet_WriteUsage(t *testing.T) {
+	t.Parallel()
+	fs := New().
+		BindFlag(flag.Bool("verbose", flag.Shorthand("v"), flag.Description("enable verbose output"))).
+		BindFlag(flag.String("bind-address", flag.Category("Networking"), flag.Aliases("listen"),
+			flag.DeprecatedAliases("bind-addr"), flag.Description("address to listen on"), flag.DefaultValue("localhost"))).
+		BindFlag(flag.String("log-level", flag.Category("Logging"), flag.Description("log level"))).
+		BindFlag(flag.Int("port", flag.Shorthand("p"), flag.Category("Networking"), flag.Description("port to listen on"))).
+		BindFlag(flag.Bool("debug-hooks", flag.Hidden())).
+		Build()
+	require.NoError(t, fs.Parse([]string{"--bind-address", "0.0.0.0", "--port", "8080"}))
+	var buf bytes.Buffer
+	require.NoError(t, fs.WriteUsage(&buf))
+	assert.Equal(t, `Flags:
+  -v, --verbose                 enable verbose output
+
+Networking:
+      --bind-address, --listen  address to listen on (default localhost)
+  -p, --port                    port to listen on
+
+Logging:
+      --log-level               log level
+`, buf.String())
+}
+
+func TestFlagSet_Hidden(t *testing.T) {
+	t.Parallel()
+	fs := New().
+		BindFlag(flag.Bool("debug-hooks", flag.Hidden(), flag.Shorthand("D"))).
+		BindFlag(flag.Bool("debug", flag.Category("Logging"))).
+		Build()
+	require.NoError(t, fs.Parse([]string{"--debug-hooks"}))
+	assert.True(t, GetBool(fs, "debug-hooks"))
+	f := fs.Lookup("debug-hooks")
+	require.NotNil(t, f)
+	assert.True(t, f.IsHidden())
+	assert.Equal(t, "Logging", fs.Lookup("debug").Category())
+
+	hidden := New().
+		BindFlag(flag.Int("internal-knob", flag.Hidden())).
+		BindFlag(flag.Bool("debug-internal", flag.Hidden())).
+		BindFlag(flag.Bool("debug")).
+		BindFlag(flag.Bool("debug-log")).
+		Build()
+	require.ErrorIs(t, hidden.Parse([]string{"--int", "1"}), ferrors.ErrUnknownFlag)
+	err := hidden.Parse([]string{"--deb"})
+	require.ErrorIs(t, err, ferrors.ErrAmbiguousFlag)
+	assert.NotContains(t, err.Error(), "debug-internal")
+	require.NoError(t, hidden.Parse([]string{"--internal-knob", "1"}))
+
+	var unknown *ferrors.UnknownFlagError
+	require.ErrorAs(t, fs.Parse([]string{"--debug-hoks"}), &unknown)
+	assert.Equal(t, []string{"debug"}, unknown.Suggestions)
+	require.ErrorAs(t, fs.Parse([]string{"-d"}), &unknown)
+	assert.Empty(t, unknown.Suggestions)
+}
+
+func TestFlagSet_ResponseFiles(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+	nested := writeFile("nested.txt", "--tag 'nested tag'\n")
+	args := writeFile("args.txt", `# build options
+--name "sample \"name\"" --count 5
+--tag first\ tag @`+nested+`
+--tag @@at
+`)
+	newFlagSet := func() *FlagSet {
+		return New().
+			ResponseFiles().
+			BindFlag(flag.String("name")).
+			BindFlag(flag.Int("count")).
+			BindFlag(flag.StringSlice("tag")).
+			Build()
+	}
+
+	fs := newFlagSet()
+	require.NoError(t, fs.Parse([]string{"@" + args, "--tag", "@@last"}))
+	assert.Equal(t, `sample "name"`, GetString(fs, "name"))
+	assert.Equal(t, 5, GetInt(fs, "count"))
+	assert.Equal(t, []string{"first tag", "nested tag", "@at", "@last"}, GetStringSlice(fs, "tag"))
+
+	err := newFlagSet().Parse([]string{"@" + filepath.Join(dir, "missing.txt")})
+	require.ErrorIs(t, err, ferrors.ErrResponseFile)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	unterminated := writeFile("unterminated.txt", `--name "sample`)
+	require.ErrorIs(t, newFlagSet().Parse([]string{"@" + unterminated}), ferrors.ErrUnterminatedQuote)
+
+	recursive := filepath.Join(dir, "recursive.txt")
+	writeFile("recursive.txt", "@"+recursive)
+	require.ErrorIs(t, newFlagSet().Parse([]string{"@" + recursive}), ferrors.ErrResponseFileDepth)
+
+	fs = New().BindFlag(flag.String("name")).Build()
+	require.NoError(t, fs.Parse([]string{"--name", "@" + args}))
+	assert.Equal(t, "@"+args, GetString(fs, "name"))
+}
+
+func TestFlagSet_ResponseFilesWithValueFromFile(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+	query := writeFile("query.sql", "SELECT a, b FROM t;\n")
+	cert := writeFile("cert.pem", "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n")
+	args := writeFile("args.txt", "--cert @"+cert+"\n")
+	newFlagSet := func(mode ParseMode) *FlagSet {
+		return New().
+			ResponseFiles().
+			ParseMode(mode).
+			BindFlag(flag.String("query", flag.ValueFromFile(), flag.Shorthand("q"))).
+			BindFlag(flag.String("cert", flag.ValueFromFile())).
+			BindFlag(flag.Bool("verbose", flag.Shorthand("v"))).
+			Build()
+	}
+
+	fs := newFlagSet(ParseModeDefault)
+	require.NoError(t, fs.Parse([]string{"--query", "@" + query, "@" + args}))
+	assert.Equal(t, "SELECT a, b FROM t;", GetString(fs, "query"))
+	assert.Equal(t, query, fs.Lookup("query").ValueFile())
+	assert.Equal(t, "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----", GetString(fs, "cert"))
+	assert.Equal(t, cert, fs.Lookup("cert").ValueFile())
+
+	fs = newFlagSet(ParseModeDefault)
+	require.NoError(t, fs.Parse([]string{"-vq", "@" + query}))
+	assert.Equal(t, query, fs.Lookup("query").ValueFile())
+
+	fs = newFlagSet(ParseModeDefault)
+	require.NoError(t, fs.Parse([]string{"--query", "@@literal"}))
+	assert.Equal(t, "@literal", GetString(fs, "query"))
+	assert.Empty(t, fs.Lookup("query").ValueFile())
+
+	fs = newFlagSet(ParseModeGo)
+	require.NoError(t, fs.Parse([]string{"-query", "@" + query}))
+	assert.Equal(t, query, fs.Lookup("query").ValueFile())
+}
+
+func TestSplitArgs(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+		err      error
+	}{
+		{name: "empty", content: " \n\t ", expected: nil},
+		{name: "whitespace", content: "--a  1\n\t--b\r\n2", expected: []string{"--a", "1", "--b", "2"}},
+		{name: "single quotes", content: `'a "b" \c'`, expected: []string{`a "b" \c`}},
+		{name: "double quotes", content: `"a 'b' \"c\" \d \\"`, expected: []string{`a 'b' "c" \d \`}},
+		{name: "empty quotes", content: `'' ""`, expected: []string{"", ""}},
+		{name: "adjacent quotes", content: `--name="a b"'c'`, expected: []string{"--name=a bc"}},
+		{name: "backslash", content: `a\ b \'c`, expected: []string{"a b", "'c"}},
+		{name: "line continuation", content: "a\\\nb", expected: []string{"ab"}},
+		{name: "line continuation between args", content: "--tag a \\\n --name b", expected: []string{"--tag", "a", "--name", "b"}},
+		{name: "trailing backslash", content: `a \`, expected: []string{"a", `\`}},
+		{name: "comments", content: "# comment\na#b # c\nd", expected: []string{"a#b", "d"}},
+		{name: "unterminated quote", content: `"a`, err: ferrors.ErrUnterminatedQuote},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			args, err := splitArgs(tt.content)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, args)
+		})
+	}
+}
+
+func TestFlagSet_ValueFromFile(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "query.sql")
+	require.NoError(t, os.WriteFile(path, []byte("SELECT 1;\n"), 0o600))
+	fs := New().
+		BindFlag(flag.String("query", flag.ValueFromFile(), flag.Description("query to run"))).
+		BindFlag(flag.String("output")).
+		Build()
+	require.NoError(t, fs.Parse([]string{"--query", "@" + path, "--output", "-"}))
+	assert.Equal(t, "SELECT 1;", GetString(fs, "query"))
+	assert.Equal(t, "-", GetString(fs, "output"))
+	assert.Equal(t, path, fs.Lookup("query").ValueFile())
+
+	var buf bytes.Buffer
+	require.NoError(t, fs.WriteUsage(&buf))
+	assert.Contains(t, buf.String(), "query to run (value read from @"+path+")\n")
+	assert.NotContains(t, buf.String(), "SELECT")
+}
+
+func TestFlagSet_Secret(t *testing.T) {
+	t.Parallel()
+	fs := New().
+		BindFlag(flag.String("token", flag.Secret(), flag.DefaultValue("default-token"), flag.Description("API token"))).
+		BindFlag(flag.Int("pin", flag.Secret())).
+		Build()
+	var buf bytes.Buffer
+	require.NoError(t, fs.WriteUsage(&buf))
+	assert.Contains(t, buf.String(), "API token (default [REDACTED])")
+	assert.NotContains(t, buf.String(), "default-token")
+
+	require.NoError(t, fs.Parse([]string{"--token", "s3cr3t"}))
+	assert.Equal(t, "s3cr3t", GetString(fs, "token"))
+	assert.Equal(t, "[REDACTED]", fs.Lookup("token").String())
+
+	err := fs.Parse([]string{"--pin", "12x4"})
+	require.ErrorIs(t, err, ferrors.ErrParseFailed)
+	require.ErrorIs(t, err, strconv.ErrSyntax)
+	assert.NotContains(t, err.Error(), "12x4")
+	assert.NotContains(t, fmt.Sprintf("%+v", err), "12x4")
+	for chain := []error{err}; len(chain) > 0; chain = chain[1:] {
+		assert.NotContains(t, chain[0].Error(), "12x4")
+		switch e := chain[0].(type) {
+		case interface{ Unwrap() error }:
+			chain = append(chain, e.Unwrap())
+		case interface{ Unwrap() []error }:
+			chain = append(chain, e.Unwrap()...)
+		}
+	}
+}
+
+func TestFlagSet_Export(t *testing.T) {
+	t.Parallel()
+	newFlagSet := func() *FlagSet {
+		fs := New(env.Prefix("app"), env.Capitalized(), env.VarNameReplace("-", "_")).
+			BindFlag(flag.String("name", flag.DefaultValue("it's me"))).
+			BindFlag(flag.Int("port", flag.DefaultValue(8080))).
+			BindFlag(flag.Bool("verbose")).
+			BindFlag(flag.Duration("timeout")).
+			BindFlag(flag.StringSlice("tag")).
+			BindFlag(flag.String("token", flag.Secret())).
+			Build()
+		require.NoError(t, fs.Parse([]string{"--port", "9090", "--timeout", "1m30s", "--tag", "a", "b", "--token", "s3cr3t"}))
+		return fs
+	}
+	tests := []struct {
+		name     string
+		format   ExportFormat
+		opts     []ExportOption
+		expected string
+	}{
+		{
+			name:   "json",
+			format: ExportJSON,
+			expected: `{
+  "name": "it's me",
+  "port": 9090,
+  "verbose": null,
+  "timeout": "1m30s",
+  "tag": [
+    "a",
+    "b"
+  ],
+  "token": "[REDACTED]"
+}
+`,
+		},
+		{
+			name:   "yaml",
+			format: ExportYAML,
+			expected: `"name": "it's me"
+"port": 9090
+"verbose": null
+"timeout": "1m30s"
+"tag": ["a","b"]
+"token": "[REDACTED]"
+`,
+		},
+		{
+			name:   "env",
+			format: ExportEnv,
+			expected: `APP_NAME="it's me"
+APP_PORT=9090
+APP_TIMEOUT=1m30s
+APP_TAG=a,b
+APP_TOKEN='[REDACTED]'
+`,
+		},
+		{
+			name:     "command line",
+			format:   ExportCommandLine,
+			expected: "'--name=it'\\''s me' --port=9090 --timeout=1m30s --tag=a,b '--token=[REDACTED]'\n",
+		},
+		{
+			name:     "non-default only",
+			format:   ExportYAML,
+			opts:     []ExportOption{NonDefaultOnly()},
+			expected: "\"port\": 9090\n\"timeout\": \"1m30s\"\n\"tag\": [\"a\",\"b\"]\n\"token\": \"[REDACTED]\"\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var buf bytes.Buffer
+			require.NoError(t, newFlagSet().Export(&buf, tt.format, tt.opts...))
+			assert.Equal(t, tt.expected, buf.String())
+		})
+	}
+}
+
+func TestFlagSet_ExportEnvRoundTrip(t *testing.T) {
+	t.Parallel()
+	newFlagSet := func() *FlagSet {
+		return New(env.Prefix("dotenv"), env.Capitalized(), env.VarNameReplace("-", "_")).
+			BindFlag(flag.String("city")).
+			BindFlag(flag.String("greeting")).
+			BindFlag(flag.String("path")).
+			BindFlag(flag.String("note")).
+			Build()
+	}
+	values := map[string]string{
+		"city":     "Zürich, café 東京",
+		"greeting": "it's \"${HOME}\"\nnaïve",
+		"path":     `C:\Temp\ü`,
+		"note":     "tab\tand $dollar",
+	}
+	fs := newFlagSet()
+	for name, v := range values {
+		require.NoError(t, fs.Lookup(name).FromCommandLine(v))
+	}
+	var buf bytes.Buffer
+	require.NoError(t, fs.Export(&buf, ExportEnv))
+	assert.Contains(t, buf.String(), "DOTENV_CITY='Zürich, café 東京'\n")
+	assert.Contains(t, buf.String(), `DOTENV_GREETING="it's \"\${HOME}\"\nnaïve"`+"\n")
+
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+		name, value, ok := strings.Cut(line, "=")
+		require.True(t, ok, line)
+		require.NoError(t, os.Setenv(name, parseDotenvValue(t, value)))
+	}
+	imported := newFlagSet()
+	require.NoError(t, imported.BindEnvVars())
+	for name, v := range values {
+		assert.Equal(t, v, GetString(imported, name), name)
+	}
+}
+
+// parseDotenvValue decodes the value the way dotenv parsers do: single quotes keep the value literal,
+// and double quotes take backslash escapes.
+func parseDotenvValue(t *testing.T, value string) string {
+	t.Helper()
+	switch {
+	case strings.HasPrefix(value, "'"):
+		require.True(t, strings.HasSuffix(value, "'"), value)
+		return value[1 : len(value)-1]
+	case strings.HasPrefix(value, `"`):
+		require.True(t, strings.HasSuffix(value, `"`), value)
+		var decoded strings.Builder
+		escaped := false
+		for _, r := range value[1 : len(value)-1] {
+			switch {
+			case escaped && r == 'n':
+				decoded.WriteRune('\n')
+			case escaped && r == 'r':
+				decoded.WriteRune('\r')
+			case escaped:
+				decoded.WriteRune(r)
+			case r == '\\':
+				escaped = true
+				continue
+			default:
+				decoded.WriteRune(r)
+			}
+			escaped = false
+		}
+		return decoded.String()
+	default:
+		return value
+	}
+}
+
+func TestFlagSet_ExportFormatting(t *testing.T) {
+	t.Parallel()
+	fs := New().
+		BindFlag(flag.Bool("on")).
+		BindFlag(flag.URLSlice("mirrors")).
+		BindFlag(flag.Time("since", flag.TimeLayouts(time.DateOnly))).
+		BindFlag(flag.ByteSizeSlice("limits")).
+		BindFlag(flag.Map[string, time.Duration]("timeouts")).
+		Build()
+	require.NoError(t, fs.Parse([]string{
+		"--on", "--mirrors", "https://a.com/x,https://b.com", "--since", "2024-05-01",
+		"--limits", "1KiB,1500B", "--timeouts", "read=1s,write=1m30s",
+	}))
+	var buf bytes.Buffer
+	require.NoError(t, fs.Export(&buf, ExportYAML))
+	assert.Equal(t, `"on": true
+"mirrors": ["https://a.com/x","https://b.com"]
+"since": "2024-05-01"
+"limits": ["1KiB","1.5kB"]
+"timeouts": {"read":"1s","write":"1m30s"}
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, fs.Export(&buf, ExportCommandLine))
+	args, err := splitArgs(buf.String())
+	require.NoError(t, err)
+	assert.Equal(t, fs.Arguments(), args)
+}
+
+func TestFlagSet_Arguments(t *testing.T) {
+	t.Parallel()
+	type point struct{ x, y int }
+	newFlagSet := func() *FlagSet {
+		return New().
+			BindFlag(flag.String("name")).
+			BindFlag(flag.String("token", flag.Secret())).
+			BindFlag(flag.Int("offset")).
+			BindFlag(flag.Bool("verbose", flag.Shorthand("v"))).
+			BindFlag(flag.TriBool("color")).
+			BindFlag(flag.Counter("level", flag.Shorthand("l"))).
+			BindFlag(flag.Duration("timeout")).
+			BindFlag(flag.Time("since")).
+			BindFlag(flag.ByteSize("limit")).
+			BindFlag(flag.Quantity("cpu")).
+			BindFlag(flag.IP("addr")).
+			BindFlag(flag.Prefix("net")).
+			BindFlag(flag.HostPort("endpoint")).
+			BindFlag(flag.URL("url")).
+			BindFlag(flag.StringSlice("tag")).
+			BindFlag(flag.DurationSlice("backoff")).
+			BindFlag(flag.StringMap("label")).
+			BindFlag(flag.Choice("format", []string{"json", "text"})).
+			BindFlag(flag.Typed[point]("point",
+				flag.ContextParser(parser.ContextParserFunc(func(_ parser.Context, input string) (any, error) {
+					var p point
+					_, err := fmt.Sscanf(input, "%d:%d", &p.x, &p.y)
+					return &p, err
+				})),
+				flag.Formatter(func(v any) string {
+					p := v.(point)
+					return fmt.Sprintf("%d:%d", p.x, p.y)
+				}))).
+			BindFlag(flag.String("unset", flag.DefaultValue("default"))).
+			Build()
+	}
+	fs := newFlagSet()
+	require.NoError(t, fs.Parse([]string{
+		"--name", "it's me", "--token", "s3cr3t", "--offset", "-5", "-vll", "--level", "--no-color",
+		"--timeout", "1m30s", "--since", "2024-01-02T03:04:05.123+02:00", "--limit", "10MiB", "--cpu", "1.5k",
+		"--addr", "::1", "--net", "10.0.0.0/8", "--endpoint", "localhost:8080", "--url", "https://example.com/a?b=c",
+		"--tag", "a", "b", "--tag", "c", "--backoff", "1s,2s", "--label", "k1=v1,k2=v2", "--format", "json",
+		"--point", "1:2",
+	}))
+	args := fs.Arguments()
+	assert.Contains(t, args, "--token=s3cr3t")
+	assert.Contains(t, args, "--level=3")
+	assert.Contains(t, args, "--point=1:2")
+	assert.NotContains(t, args, "--unset=default")
+
+	restored := newFlagSet()
+	require.NoError(t, restored.Parse(args))
+	fs.VisitAll(func(f Flag) {
+		assert.Equal(t, f.Value(), restored.Lookup(f.Name()).Value(), f.Name())
+	})
+	assert.Equal(t, args, restored.Arguments())
+}
+
+func TestFlagSet_ArgumentsRoundTrip(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		newFlag func() Flag
+		input   []string
+	}{
+		{"string", func() Flag { return flag.String("v") }, []string{"--v", "a b,c"}},
+		{"bool", func() Flag { return flag.Bool("v") }, []string{"--v"}},
+		{"tribool", func() Flag { return flag.TriBool("v") }, []string{"--no-v"}},
+		{"counter", func() Flag { return flag.Counter("v") }, []string{"--v", "--v"}},
+		{"int", func() Flag { return flag.Int("v") }, []string{"--v", "-42"}},
+		{"int8", func() Flag { return flag.Int8("v") }, []string{"--v", "-128"}},
+		{"int16", func() Flag { return flag.Int16("v") }, []string{"--v", "32767"}},
+		{"int32", func() Flag { return flag.Int32("v") }, []string{"--v", "-2147483648"}},
+		{"int64", func() Flag { return flag.Int64("v") }, []string{"--v", "9223372036854775807"}},
+		{"uint", func() Flag { return flag.Uint("v") }, []string{"--v", "42"}},
+		{"uint8", func() Flag { return flag.Uint8("v") }, []string{"--v", "255"}},
+		{"uint16", func() Flag { return flag.Uint16("v") }, []string{"--v", "65535"}},
+		{"uint32", func() Flag { return flag.Uint32("v") }, []string{"--v", "4294967295"}},
+		{"uint64", func() Flag { return flag.Uint64("v") }, []string{"--v", "18446744073709551615"}},
+		{"float32", func() Flag { return flag.Float32("v") }, []string{"--v", "0.1"}},
+		{"float64", func() Flag { return flag.Float64("v") }, []string{"--v", "-1e-300"}},
+		{"duration", func() Flag { return flag.Duration("v") }, []string{"--v", "1h2m3.5s"}},
+		{"time", func() Flag { return flag.Time("v") }, []string{"--v", "2024-01-02T03:04:05.123456789+02:00"}},
+		{"time with layout", func() Flag { return flag.Time("v", flag.TimeLayouts(time.DateOnly)) }, []string{"--v", "2024-05-01"}},
+		{"byteSize", func() Flag { return flag.ByteSize("v") }, []string{"--v", "1234567890123456789"}},
+		{"quantity", func() Flag { return flag.Quantity("v") }, []string{"--v", "-1234567890123456789"}},
+		{"ip", func() Flag { return flag.IP("v") }, []string{"--v", "fe80::1"}},
+		{"prefix", func() Flag { return flag.Prefix("v") }, []string{"--v", "10.0.0.0/8"}},
+		{"host port", func() Flag { return flag.HostPort("v") }, []string{"--v", "[::1]:8080"}},
+		{"url", func() Flag { return flag.URL("v") }, []string{"--v", "https://user@example.com/a?b=c#d"}},
+		{"choice", func() Flag { return flag.Choice("v", []string{"json", "text"}) }, []string{"--v", "text"}},
+		{"string slice", func() Flag { return flag.StringSlice("v") }, []string{"--v", "a", "b", "--v", "c"}},
+		{"bool slice", func() Flag { return flag.BoolSlice("v") }, []string{"--v", "true,false"}},
+		{"int slice", func() Flag { return flag.IntSlice("v") }, []string{"--v", "1,-2"}},
+		{"int8 slice", func() Flag { return flag.Int8Slice("v") }, []string{"--v", "1,-2"}},
+		{"int16 slice", func() Flag { return flag.Int16Slice("v") }, []string{"--v", "1,-2"}},
+		{"int32 slice", func() Flag { return flag.Int32Slice("v") }, []string{"--v", "1,-2"}},
+		{"int64 slice", func() Flag { return flag.Int64Slice("v") }, []string{"--v", "1,-2"}},
+		{"uint slice", func() Flag { return flag.UintSlice("v") }, []string{"--v", "1,2"}},
+		{"uint8 slice", func() Flag { return flag.Uint8Slice("v") }, []string{"--v", "1,2"}},
+		{"uint16 slice", func() Flag { return flag.Uint16Slice("v") }, []string{"--v", "1,2"}},
+		{"uint32 slice", func() Flag { return flag.Uint32Slice("v") }, []string{"--v", "1,2"}},
+		{"uint64 slice", func() Flag { return flag.Uint64Slice("v") }, []string{"--v", "1,2"}},
+		{"float32 slice", func() Flag { return flag.Float32Slice("v") }, []string{"--v", "0.1,2.5"}},
+		{"float64 slice", func() Flag { return flag.Float64Slice("v") }, []string{"--v", "0.1,2.5"}},
+		{"duration slice", func() Flag { return flag.DurationSlice("v") }, []string{"--v", "1s,1m30s"}},
+		{"size slice", func() Flag { return flag.ByteSizeSlice("v") }, []string{"--v", "1KiB,1234567B"}},
+		{"quantity slice", func() Flag { return flag.QuantitySlice("v") }, []string{"--v", "1.5k,-7"}},
+		{"ip slice", func() Flag { return flag.IPSlice("v") }, []string{"--v", "10.0.0.1,::1"}},
+		{"prefix slice", func() Flag { return flag.PrefixSlice("v") }, []string{"--v", "10.0.0.0/8,fd00::/8"}},
+		{"host port slice", func() Flag { return flag.HostPortSlice("v") }, []string{"--v", "a:1,b:2"}},
+		{"url slice", func() Flag { return flag.URLSlice("v") }, []string{"--v", "https://a.com/x?y=z,https://b.com"}},
+		{"string map", func() Flag { return flag.StringMap("v") }, []string{"--v", "b=2,a=1"}},
+		{"int map", func() Flag { return flag.IntMap("v") }, []string{"--v", "b=2,a=-1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			fs := New().BindFlag(tt.newFlag()).Build()
+			require.NoError(t, fs.Parse(tt.input))
+			args := fs.Arguments()
+			restored := New().BindFlag(tt.newFlag()).Build()
+			require.NoError(t, restored.Parse(args), args)
+			assert.Equal(t, fs.Lookup("v").Value(), restored.Lookup("v").Value(), args)
+			assert.Equal(t, args, restored.Arguments())
+		})
+	}
+}
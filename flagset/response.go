@@ -0,0 +1,152 @@
+package flagset
+
+import (
+	"os"
+	"strings"
+	"unicode"
+
+	ferrors "github.com/brongineer/helium/errors"
+)
+
+const (
+	responseFilePrefix   = "@"
+	maxResponseFileDepth = 10
+	// doubleQuoteEscapes are the characters which may be escaped with a backslash inside double quotes.
+	doubleQuoteEscapes = "\\\"$`\n"
+)
+
+// expandResponseFiles replaces the `@path` arguments with the arguments read from the files.
+// Response files may refer to other response files, up to maxResponseFileDepth levels deep.
+// Arguments starting with `@@` are kept with the first at-sign removed. The argument following
+// the flag which reads its value from a file is kept as is, so that the flag reads the file itself.
+func (fs *FlagSet) expandResponseFiles(args []string, depth int) ([]string, error) {
+	expanded := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case fs.readsValueFile(arg) && i+1 < len(args):
+			expanded = append(expanded, arg, args[i+1])
+			i++
+		case strings.HasPrefix(arg, responseFilePrefix+responseFilePrefix):
+			expanded = append(expanded, strings.TrimPrefix(arg, responseFilePrefix))
+		case strings.HasPrefix(arg, responseFilePrefix) && len(arg) > len(responseFilePrefix):
+			path := strings.TrimPrefix(arg, responseFilePrefix)
+			if depth == maxResponseFileDepth {
+				return nil, ferrors.ResponseFileDepth(path, maxResponseFileDepth)
+			}
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return nil, ferrors.ResponseFile(path, err)
+			}
+			fileArgs, err := splitArgs(string(content))
+			if err != nil {
+				return nil, ferrors.ResponseFile(path, err)
+			}
+			if fileArgs, err = fs.expandResponseFiles(fileArgs, depth+1); err != nil {
+				return nil, err
+			}
+			expanded = append(expanded, fileArgs...)
+		default:
+			expanded = append(expanded, arg)
+		}
+	}
+	return expanded, nil
+}
+
+// readsValueFile reports whether the argument is the name of the flag which reads its value
+// from a file, given without the inline value, so that the flag takes the next argument.
+// Of the stacked shorthands, only the last one takes the next argument.
+func (fs *FlagSet) readsValueFile(arg string) bool {
+	if !isShortFlag(arg) || strings.Contains(arg, inlineValueSeparator) {
+		return false
+	}
+	var f flagItem
+	switch name := strings.TrimPrefix(arg, shortFlagNamePrefix); {
+	case strings.HasPrefix(arg, longFlagNamePrefix):
+		if name = strings.TrimPrefix(arg, longFlagNamePrefix); name != "" {
+			f = fs.flagByName(name)
+		}
+	case fs.mode == ParseModeGo:
+		f = fs.flagBySingleDashName(name)
+	default:
+		stacked := strings.Split(name, "")
+		f = fs.flagByShorthand(stacked[len(stacked)-1])
+	}
+	if f == nil || !f.IsValueFromFile() || f.IsBoolFlag() {
+		return false
+	}
+	_, noArg := f.NoArgValue()
+	return !noArg
+}
+
+// splitArgs splits the content of the response file into arguments following the shell rules:
+// arguments are separated by whitespace, single quotes preserve every character, double quotes
+// preserve every character but the backslash escaping one of "\$` and the newline, a backslash
+// outside quotes preserves the next character (a backslash followed by a newline joins the lines),
+// and `#` at the beginning of an argument starts the comment running to the end of the line.
+func splitArgs(content string) ([]string, error) {
+	var (
+		args    []string
+		arg     strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+		comment bool
+	)
+	for _, r := range content {
+		switch {
+		case comment:
+			comment = r != '\n'
+		case escaped:
+			escaped = false
+			if quote == '"' && !strings.ContainsRune(doubleQuoteEscapes, r) {
+				arg.WriteRune('\\')
+			}
+			if r != '\n' {
+				arg.WriteRune(r)
+				inArg = true
+			}
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				arg.WriteRune(r)
+			}
+		case quote == '"':
+			switch r {
+			case '"':
+				quote = 0
+			case '\\':
+				escaped = true
+			default:
+				arg.WriteRune(r)
+			}
+		case r == '\\':
+			escaped = true
+		case r == '\'' || r == '"':
+			quote, inArg = r, true
+		case unicode.IsSpace(r):
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		case r == '#' && !inArg:
+			comment = true
+		default:
+			arg.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 {
+		return nil, ferrors.ErrUnterminatedQuote
+	}
+	if escaped {
+		arg.WriteRune('\\')
+		inArg = true
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args, nil
+}
@@ -0,0 +1,112 @@
+package flagset
+
+import (
+	"slices"
+	"strings"
+)
+
+const (
+	// minSuggestedPrefix is the minimum length of the name being the prefix of the other one,
+	// for the names to be taken as similar regardless of the edit distance.
+	minSuggestedPrefix = 3
+	// maxSuggestions is the maximum number of the suggested names.
+	maxSuggestions = 3
+)
+
+// suggestNames returns the names similar to the given one: the ones within the edit distance
+// of a third of the name length (at least one), and the ones which are prefixes of the name
+// or start with it, if the shorter of the names has at least minSuggestedPrefix characters.
+// Suggestions are sorted by the edit distance, and then by the name, and there are no more
+// than maxSuggestions of them. Nothing is suggested for the empty name.
+func suggestNames(name string, names []string) []string {
+	if name == "" {
+		return nil
+	}
+	type suggestion struct {
+		name     string
+		distance int
+	}
+	var (
+		found       []suggestion
+		maxDistance = max(1, len(name)/3)
+	)
+	for _, n := range names {
+		if n == "" || n == name {
+			continue
+		}
+		d := editDistance(name, n)
+		if d <= maxDistance || isLongPrefix(name, n) || isLongPrefix(n, name) {
+			found = append(found, suggestion{n, d})
+		}
+	}
+	if len(found) == 0 {
+		return nil
+	}
+	slices.SortFunc(found, func(a, b suggestion) int {
+		if a.distance != b.distance {
+			return a.distance - b.distance
+		}
+		return strings.Compare(a.name, b.name)
+	})
+	found = found[:min(len(found), maxSuggestions)]
+	suggestions := make([]string, 0, len(found))
+	for _, s := range found {
+		suggestions = append(suggestions, s.name)
+	}
+	return suggestions
+}
+
+// isLongPrefix reports whether the prefix is the prefix of s having at least minSuggestedPrefix characters.
+func isLongPrefix(s, prefix string) bool {
+	return len([]rune(prefix)) >= minSuggestedPrefix && strings.HasPrefix(s, prefix)
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// suggestFlags returns the names of the flags similar to the unknown flag name.
+// Hidden flags are never suggested.
+func (fs *FlagSet) suggestFlags(name string) []string {
+	names := make([]string, 0, len(fs.flags))
+	for _, f := range fs.flags {
+		if f.IsHidden() {
+			continue
+		}
+		names = append(names, f.Name())
+	}
+	return suggestNames(name, names)
+}
+
+// suggestShorthands returns the shorthands which differ from the unknown one only in case.
+// Shorthands of hidden flags are never suggested.
+func (fs *FlagSet) suggestShorthands(shorthand string) []string {
+	var suggestions []string
+	for _, f := range fs.flags {
+		if f.IsHidden() {
+			continue
+		}
+		if s := f.Shorthand(); s != shorthand && strings.EqualFold(s, shorthand) {
+			suggestions = append(suggestions, s)
+		}
+	}
+	return suggestions
+}
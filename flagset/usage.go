@@ -0,0 +1,96 @@
+package flagset
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	usageIndent      = "  "
+	usagePadding     = "  "
+	defaultUsageHead = "Flags"
+	stdinValueFile   = "-"
+)
+
+// WriteUsage writes the help for the flags of the FlagSet to w. Flags are listed
+// in the order they were bound, starting with the ones without a category, followed
+// by the groups of categorized flags in the order the categories first appear.
+// Each flag is listed with its shorthand, aliases, description, choices and default value.
+// For the values read from a file, the path is shown instead of the content.
+// Hidden flags and deprecated aliases are omitted.
+func (fs *FlagSet) WriteUsage(w io.Writer) error {
+	var (
+		categories []string
+		groups     = make(map[string][]flagItem)
+	)
+	for _, f := range fs.flags {
+		if f.IsHidden() {
+			continue
+		}
+		c := f.Category()
+		if _, ok := groups[c]; !ok && c != "" {
+			categories = append(categories, c)
+		}
+		groups[c] = append(groups[c], f)
+	}
+	if _, ok := groups[""]; ok {
+		categories = append([]string{""}, categories...)
+	}
+	width := 0
+	for _, f := range fs.flags {
+		if !f.IsHidden() {
+			width = max(width, len(usageNames(f)))
+		}
+	}
+	var b strings.Builder
+	for i, c := range categories {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		head := c
+		if head == "" {
+			head = defaultUsageHead
+		}
+		_, _ = fmt.Fprintf(&b, "%s:\n", head)
+		for _, f := range groups[c] {
+			line := fmt.Sprintf("%s%-*s%s%s", usageIndent, width, usageNames(f), usagePadding, usageDescription(f))
+			b.WriteString(strings.TrimRight(line, " ") + "\n")
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// usageNames returns the shorthand, the name and the aliases of the flag, e.g. `-p, --port, --listen-port`.
+// The names of the flags without a shorthand are aligned with the names of the ones having it.
+func usageNames(f flagItem) string {
+	names := make([]string, 0, len(f.Aliases())+1)
+	for _, name := range append([]string{f.Name()}, f.Aliases()...) {
+		names = append(names, longFlagNamePrefix+name)
+	}
+	prefix := strings.Repeat(" ", len(shortFlagNamePrefix)+len(", ")+1)
+	if f.Shorthand() != "" {
+		prefix = shortFlagNamePrefix + f.Shorthand() + ", "
+	}
+	return prefix + strings.Join(names, ", ")
+}
+
+// usageDescription returns the description of the flag followed by its choices and default value.
+func usageDescription(f flagItem) string {
+	desc := f.Description()
+	if choices := f.Choices(); len(choices) > 0 {
+		desc += fmt.Sprintf(" (one of: %s)", strings.Join(choices, ", "))
+	}
+	if def := f.DefaultString(); def != "" {
+		desc += fmt.Sprintf(" (default %s)", def)
+	}
+	switch file := f.ValueFile(); file {
+	case "":
+	case stdinValueFile:
+		desc += " (value read from stdin)"
+	default:
+		desc += fmt.Sprintf(" (value read from @%s)", file)
+	}
+	return strings.TrimSpace(desc)
+}
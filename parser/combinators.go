@@ -0,0 +1,304 @@
+package parser
+
+import (
+	"errors"
+	"strings"
+
+	ferrors "github.com/brongineer/helium/errors"
+)
+
+const (
+	defaultSeparator  = ","
+	keyValueSeparator = "="
+)
+
+// ValueParser is a FlagParser which is also able to parse a single input into the value
+// of type T. Value parsers are the building blocks, which can be combined into parsers
+// of more complex values.
+type ValueParser[T any] interface {
+	FlagParser
+	Parse(string) (T, error)
+}
+
+// FuncParser parses the flag value with the given function. Being used as a flag parser,
+// it rejects empty command-line input and repeated command-line occurrences of the flag.
+type FuncParser[T any] struct {
+	*EmbeddedParser
+	parse func(string) (T, error)
+}
+
+// Func creates a parser calling the given function for the input.
+func Func[T any](parse func(string) (T, error)) *FuncParser[T] {
+	return &FuncParser[T]{&EmbeddedParser{}, parse}
+}
+
+func (p *FuncParser[T]) Parse(input string) (T, error) {
+	return p.parse(input)
+}
+
+func (p *FuncParser[T]) ParseCmd(input string) (any, error) {
+	if p.IsSetFromCmd() {
+		return nil, ferrors.ErrFlagVisited
+	}
+	var empty string
+	if input == empty {
+		return nil, ferrors.ErrNoValueProvided
+	}
+	return p.ParseEnv(input)
+}
+
+func (p *FuncParser[T]) ParseEnv(input string) (any, error) {
+	parsed, err := p.parse(input)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+// SliceParser splits the input by the flag separator and parses every element with the
+// element parser. Being used as a flag parser, it appends the values of repeated
+// command-line occurrences of the flag.
+type SliceParser[T any] struct {
+	*EmbeddedParser
+	elem ValueParser[T]
+}
+
+// Slice creates a parser of []T using the given parser for the elements.
+func Slice[T any](elem ValueParser[T]) *SliceParser[T] {
+	return &SliceParser[T]{&EmbeddedParser{}, elem}
+}
+
+func (p *SliceParser[T]) Parse(input string) ([]T, error) {
+	s := strings.Split(input, separatorOrDefault(p.Separator()))
+	parsed := make([]T, 0, len(s))
+	for _, el := range s {
+		v, err := p.elem.Parse(el)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, v)
+	}
+	return parsed, nil
+}
+
+func (p *SliceParser[T]) ParseCmd(input string) (any, error) {
+	var empty string
+	if input == empty {
+		return nil, ferrors.ErrNoValueProvided
+	}
+	parsed, err := p.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+	if stored, ok := p.CurrentValue().(*[]T); ok && stored != nil && p.IsSetFromCmd() {
+		parsed = append(*stored, parsed...)
+	}
+	return &parsed, nil
+}
+
+func (p *SliceParser[T]) ParseEnv(input string) (any, error) {
+	parsed, err := p.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+// AcceptsLeadingDash reports whether the element parser accepts the input which starts with a dash.
+func (p *SliceParser[T]) AcceptsLeadingDash() bool {
+	return acceptsLeadingDash(p.elem)
+}
+
+// MapParser splits the input by the flag separator into `key=value` pairs and parses
+// keys and values with the given parsers. Being used as a flag parser, it merges
+// the pairs of repeated command-line occurrences of the flag.
+type MapParser[K comparable, V any] struct {
+	*EmbeddedParser
+	key        ValueParser[K]
+	value      ValueParser[V]
+	uniqueKeys bool
+}
+
+// Map creates a parser of map[K]V using the given parsers for the keys and the values.
+// If the key is provided more than once, the last value wins.
+func Map[K comparable, V any](key ValueParser[K], value ValueParser[V]) *MapParser[K, V] {
+	return &MapParser[K, V]{EmbeddedParser: &EmbeddedParser{}, key: key, value: value}
+}
+
+// StrictMap is the same as Map, but it fails if the key is provided more than once.
+func StrictMap[K comparable, V any](key ValueParser[K], value ValueParser[V]) *MapParser[K, V] {
+	p := Map(key, value)
+	p.uniqueKeys = true
+	return p
+}
+
+func (p *MapParser[K, V]) parseInto(input string, parsed map[K]V) error {
+	for _, pair := range strings.Split(input, separatorOrDefault(p.Separator())) {
+		k, v, found := strings.Cut(pair, keyValueSeparator)
+		if !found || k == "" {
+			return ferrors.InvalidKeyValue(pair)
+		}
+		key, err := p.key.Parse(k)
+		if err != nil {
+			return err
+		}
+		value, err := p.value.Parse(v)
+		if err != nil {
+			return err
+		}
+		if _, exists := parsed[key]; exists && p.uniqueKeys {
+			return ferrors.DuplicateKey(k)
+		}
+		parsed[key] = value
+	}
+	return nil
+}
+
+func (p *MapParser[K, V]) Parse(input string) (map[K]V, error) {
+	parsed := make(map[K]V)
+	if err := p.parseInto(input, parsed); err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
+
+func (p *MapParser[K, V]) ParseCmd(input string) (any, error) {
+	var empty string
+	if input == empty {
+		return nil, ferrors.ErrNoValueProvided
+	}
+	parsed := make(map[K]V)
+	if stored, ok := p.CurrentValue().(*map[K]V); ok && stored != nil && p.IsSetFromCmd() {
+		for k, v := range *stored {
+			parsed[k] = v
+		}
+	}
+	if err := p.parseInto(input, parsed); err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+func (p *MapParser[K, V]) ParseEnv(input string) (any, error) {
+	parsed, err := p.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+// ValidatingParser runs the check for every value produced by the wrapped parser.
+type ValidatingParser[T any] struct {
+	ValueParser[T]
+	check func(T) error
+}
+
+// Validate wraps the parser, so that the parsed value is accepted only if the check passes.
+// For parsers accumulating repeated command-line values, the check receives the accumulated value.
+func Validate[T any](p ValueParser[T], check func(T) error) *ValidatingParser[T] {
+	return &ValidatingParser[T]{p, check}
+}
+
+func (p *ValidatingParser[T]) Parse(input string) (T, error) {
+	v, err := p.ValueParser.Parse(input)
+	if err != nil {
+		return v, err
+	}
+	return v, p.check(v)
+}
+
+func (p *ValidatingParser[T]) validate(v any, err error) (any, error) {
+	if err != nil {
+		return nil, err
+	}
+	if parsed, ok := v.(*T); ok && parsed != nil {
+		if err = p.check(*parsed); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+func (p *ValidatingParser[T]) ParseCmd(input string) (any, error) {
+	return p.validate(p.ValueParser.ParseCmd(input))
+}
+
+func (p *ValidatingParser[T]) ParseEnv(input string) (any, error) {
+	return p.validate(p.ValueParser.ParseEnv(input))
+}
+
+// AcceptsLeadingDash reports whether the wrapped parser accepts the input which starts with a dash.
+func (p *ValidatingParser[T]) AcceptsLeadingDash() bool {
+	return acceptsLeadingDash(p.ValueParser)
+}
+
+// TrimmingParser removes leading and trailing white space from the input
+// before passing it to the wrapped parser.
+type TrimmingParser[T any] struct {
+	ValueParser[T]
+}
+
+// Trim wraps the parser, so that it receives the input with leading and trailing white space removed.
+func Trim[T any](p ValueParser[T]) *TrimmingParser[T] {
+	return &TrimmingParser[T]{p}
+}
+
+func (p *TrimmingParser[T]) Parse(input string) (T, error) {
+	return p.ValueParser.Parse(strings.TrimSpace(input))
+}
+
+func (p *TrimmingParser[T]) ParseCmd(input string) (any, error) {
+	return p.ValueParser.ParseCmd(strings.TrimSpace(input))
+}
+
+func (p *TrimmingParser[T]) ParseEnv(input string) (any, error) {
+	return p.ValueParser.ParseEnv(strings.TrimSpace(input))
+}
+
+// AcceptsLeadingDash reports whether the wrapped parser accepts the input which starts with a dash.
+func (p *TrimmingParser[T]) AcceptsLeadingDash() bool {
+	return acceptsLeadingDash(p.ValueParser)
+}
+
+// SignedParser is the wrapped parser accepting the input which starts with a dash, like
+// negative numbers. Being used as a flag parser, it makes a flag set take such a command-line
+// argument as the value of the flag.
+type SignedParser[T any] struct {
+	ValueParser[T]
+}
+
+// Signed wraps the parser of the values which may be negative, e.g. `-5` or `-1.5`.
+func Signed[T any](p ValueParser[T]) *SignedParser[T] {
+	return &SignedParser[T]{p}
+}
+
+func (p *SignedParser[T]) AcceptsLeadingDash() bool {
+	return true
+}
+
+// OneOf creates a parser trying the given parsers in order and returning the value
+// produced by the first one which succeeds. If all of them fail, the errors are joined.
+// Being used as a flag parser, it follows the same rules as the one created with Func.
+func OneOf[T any](parsers ...ValueParser[T]) *FuncParser[T] {
+	return Func(func(input string) (T, error) {
+		var (
+			v    T
+			errs = make([]error, 0, len(parsers))
+		)
+		for _, p := range parsers {
+			parsed, err := p.Parse(input)
+			if err == nil {
+				return parsed, nil
+			}
+			errs = append(errs, err)
+		}
+		return v, errors.Join(errs...)
+	})
+}
+
+func separatorOrDefault(separator string) string {
+	if separator == "" {
+		return defaultSeparator
+	}
+	return separator
+}
@@ -0,0 +1,207 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	ferrors "github.com/brongineer/helium/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type parserTest struct {
+	name        string
+	parser      FlagParser
+	input       string
+	expected    any
+	err         bool
+	expectedErr error
+}
+
+func positive(v int) error {
+	if v <= 0 {
+		return fmt.Errorf("%d is not positive", v)
+	}
+	return nil
+}
+
+func TestCombinators_ParseCmd(t *testing.T) {
+	t.Parallel()
+	tests := []parserTest{
+		{
+			name:     "func",
+			parser:   Func(strconv.Atoi),
+			input:    "42",
+			expected: 42,
+		},
+		{
+			name:        "func empty input",
+			parser:      Func(strconv.Atoi),
+			input:       "",
+			err:         true,
+			expectedErr: ferrors.ErrNoValueProvided,
+		},
+		{
+			name:     "slice",
+			parser:   Slice(Func(time.ParseDuration)),
+			input:    "1s,2m",
+			expected: []time.Duration{time.Second, 2 * time.Minute},
+		},
+		{
+			name:     "slice of trimmed",
+			parser:   Slice(Trim(Func(strconv.Atoi))),
+			input:    "1, 2 , 3",
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:   "slice element error",
+			parser: Slice(Func(strconv.Atoi)),
+			input:  "1,two",
+			err:    true,
+		},
+		{
+			name:     "map",
+			parser:   Map(Func(strconv.Atoi), Func(strconv.ParseBool)),
+			input:    "1=true,2=false,1=false",
+			expected: map[int]bool{1: false, 2: false},
+		},
+		{
+			name:        "strict map",
+			parser:      StrictMap(Func(strconv.Atoi), Func(strconv.ParseBool)),
+			input:       "1=true,1=false",
+			err:         true,
+			expectedErr: ferrors.ErrDuplicateKey,
+		},
+		{
+			name:        "map invalid pair",
+			parser:      Map(Func(strconv.Atoi), Func(strconv.ParseBool)),
+			input:       "1",
+			err:         true,
+			expectedErr: ferrors.ErrInvalidKeyValue,
+		},
+		{
+			name:     "validate",
+			parser:   Validate(Func(strconv.Atoi), positive),
+			input:    "1",
+			expected: 1,
+		},
+		{
+			name:   "validate failed",
+			parser: Validate(Func(strconv.Atoi), positive),
+			input:  "-1",
+			err:    true,
+		},
+		{
+			name:     "one of",
+			parser:   OneOf(Func(strconv.Atoi), Func(func(s string) (int, error) { return len(s), nil })),
+			input:    "four",
+			expected: 4,
+		},
+		{
+			name:   "one of failed",
+			parser: OneOf(Func(strconv.Atoi), Validate(Func(strconv.Atoi), positive)),
+			input:  "four",
+			err:    true,
+		},
+		{
+			name:     "trim",
+			parser:   Trim(Func(strconv.Atoi)),
+			input:    " 42\n",
+			expected: 42,
+		},
+	}
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := tt.parser.ParseCmd(tt.input)
+			if tt.err {
+				require.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.True(t, errors.Is(err, tt.expectedErr))
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, deref(actual))
+		})
+	}
+}
+
+func deref(v any) any {
+	switch val := v.(type) {
+	case *int:
+		return *val
+	case *[]int:
+		return *val
+	case *[]time.Duration:
+		return *val
+	case *map[int]bool:
+		return *val
+	}
+	return v
+}
+
+func TestSlice_Repeated(t *testing.T) {
+	t.Parallel()
+	p := Slice(Func(strconv.Atoi))
+	p.SetSeparator(";")
+	first, err := p.ParseCmd("1;2")
+	require.NoError(t, err)
+	p.SetFromCmd(true)
+	p.SetCurrentValue(first)
+	second, err := p.ParseCmd("3")
+	require.NoError(t, err)
+	assert.Equal(t, &[]int{1, 2, 3}, second)
+	env, err := p.ParseEnv("4;5")
+	require.NoError(t, err)
+	assert.Equal(t, &[]int{4, 5}, env)
+}
+
+func TestMap_Repeated(t *testing.T) {
+	t.Parallel()
+	p := StrictMap(Func(func(s string) (string, error) { return s, nil }), Func(strconv.Atoi))
+	first, err := p.ParseCmd("a=1")
+	require.NoError(t, err)
+	p.SetFromCmd(true)
+	p.SetCurrentValue(first)
+	second, err := p.ParseCmd("b=2")
+	require.NoError(t, err)
+	assert.Equal(t, &map[string]int{"a": 1, "b": 2}, second)
+	assert.Equal(t, &map[string]int{"a": 1}, first)
+	p.SetCurrentValue(second)
+	_, err = p.ParseCmd("a=3")
+	assert.ErrorIs(t, err, ferrors.ErrDuplicateKey)
+}
+
+func TestFunc_Repeated(t *testing.T) {
+	t.Parallel()
+	p := Validate(Func(strconv.Atoi), positive)
+	p.SetFromCmd(true)
+	_, err := p.ParseCmd("1")
+	assert.ErrorIs(t, err, ferrors.ErrFlagVisited)
+	v, err := p.ParseEnv("2")
+	require.NoError(t, err)
+	assert.Equal(t, ptrTo(2), v)
+}
+
+func TestSigned_AcceptsLeadingDash(t *testing.T) {
+	t.Parallel()
+	signed := Signed(Func(strconv.Atoi))
+	v, err := signed.ParseCmd("-5")
+	require.NoError(t, err)
+	assert.Equal(t, ptrTo(-5), v)
+	for _, p := range []FlagParser{signed, Slice[int](signed), Trim[int](signed), Validate[int](signed, positive)} {
+		assert.True(t, acceptsLeadingDash(p), "%T", p)
+	}
+	for _, p := range []FlagParser{Func(strconv.Atoi), Slice(Func(strconv.Atoi)), Map(Func(strconv.Atoi), signed)} {
+		assert.False(t, acceptsLeadingDash(p), "%T", p)
+	}
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,101 @@
+package parser
+
+import "sync"
+
+// Source defines where the flag value comes from.
+type Source int
+
+const (
+	// SourceCommandLine means the value is provided as a command-line argument.
+	SourceCommandLine Source = iota
+	// SourceEnvironment means the value is provided with an environment variable.
+	SourceEnvironment
+)
+
+// Context describes the flag being parsed. Unlike the state of FlagParser,
+// it is passed along with every input, so a single ContextParser may be safely
+// shared between flags.
+type Context struct {
+	// FlagName is the name of the flag being parsed.
+	FlagName string
+	// Source is the source of the input.
+	Source Source
+	// EnvVar is the name of the environment variable holding the input.
+	// It is empty unless the Source is SourceEnvironment.
+	EnvVar string
+	// ArgIndex is the index of the flag name among the command-line arguments.
+	// It is -1 unless the Source is SourceCommandLine and the flag is parsed by a flag set.
+	ArgIndex int
+	// Separator is the separator of the flag values.
+	Separator string
+	// CurrentValue is the pointer to the value the flag currently holds, if any.
+	CurrentValue any
+	// IsSetFromCmd reports whether the flag has already been set from the command line.
+	IsSetFromCmd bool
+	// IsSetFromEnv reports whether the flag has already been set from the environment variable.
+	IsSetFromEnv bool
+	// RepeatPolicy is the policy for repeated command-line values of the flags which do not
+	// define their own policy.
+	RepeatPolicy RepeatPolicy
+	// Lookup returns the pointer to the value of another flag of the flag set by its name.
+	// It is nil if the flag is parsed outside a flag set.
+	Lookup func(name string) (any, bool)
+}
+
+// ContextParser parses the flag input with respect to the given context.
+// The returned value has to be a pointer to the value of the flag type.
+type ContextParser interface {
+	Parse(ctx Context, input string) (any, error)
+}
+
+// ContextParserFunc allows to use an ordinary function as a ContextParser.
+type ContextParserFunc func(ctx Context, input string) (any, error)
+
+func (fn ContextParserFunc) Parse(ctx Context, input string) (any, error) {
+	return fn(ctx, input)
+}
+
+type adapter struct {
+	mu sync.Mutex
+	p  FlagParser
+}
+
+// Adapt turns the FlagParser into a ContextParser. The state of the wrapped parser
+// is set from the context right before the input is passed to ParseCmd or ParseEnv.
+// Calls through the same adapter are serialized, so the adapter may be shared between flags.
+// Adapting the same FlagParser again gives another adapter, which does not serialize its calls
+// against the first one.
+func Adapt(p FlagParser) ContextParser {
+	return &adapter{p: p}
+}
+
+func (a *adapter) Parse(ctx Context, input string) (any, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.p.SetFromEnv(ctx.IsSetFromEnv)
+	a.p.SetFromCmd(ctx.IsSetFromCmd)
+	a.p.SetSeparator(ctx.Separator)
+	a.p.SetCurrentValue(ctx.CurrentValue)
+	if ctx.Source == SourceEnvironment {
+		return a.p.ParseEnv(input)
+	}
+	return a.p.ParseCmd(input)
+}
+
+// RepeatPolicy defines how the flag handles the values of its repeated command-line occurrences.
+type RepeatPolicy int
+
+const (
+	// RepeatDefault leaves repeated values to the flag parser: built-in parsers of slices,
+	// maps and counters accumulate the values, while the others reject them.
+	RepeatDefault RepeatPolicy = iota
+	// RepeatError rejects repeated values.
+	RepeatError
+	// RepeatLastWins replaces the value with the one provided last.
+	RepeatLastWins
+	// RepeatFirstWins keeps the value provided first and ignores the repeated ones.
+	RepeatFirstWins
+	// RepeatAppend passes repeated values to the flag parser along with the current value
+	// to accumulate them. Parsers which are not able to accumulate values reject them.
+	RepeatAppend
+)
@@ -0,0 +1,34 @@
+package parser
+
+import (
+	"strconv"
+	"testing"
+
+	ferrors "github.com/brongineer/helium/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapt(t *testing.T) {
+	t.Parallel()
+	p := Adapt(Slice(Func(strconv.Atoi)))
+	first, err := p.Parse(Context{Source: SourceCommandLine, Separator: ";"}, "1;2")
+	require.NoError(t, err)
+	assert.Equal(t, &[]int{1, 2}, first)
+	second, err := p.Parse(Context{Source: SourceCommandLine, Separator: ";", CurrentValue: first, IsSetFromCmd: true}, "3")
+	require.NoError(t, err)
+	assert.Equal(t, &[]int{1, 2, 3}, second)
+	env, err := p.Parse(Context{Source: SourceEnvironment, CurrentValue: second, IsSetFromCmd: true}, "4,5")
+	require.NoError(t, err)
+	assert.Equal(t, &[]int{4, 5}, env)
+}
+
+func TestAdapt_Visited(t *testing.T) {
+	t.Parallel()
+	p := Adapt(Func(strconv.Atoi))
+	_, err := p.Parse(Context{Source: SourceCommandLine, IsSetFromCmd: true}, "1")
+	assert.ErrorIs(t, err, ferrors.ErrFlagVisited)
+	v, err := p.Parse(Context{Source: SourceEnvironment, IsSetFromCmd: true}, "1")
+	require.NoError(t, err)
+	assert.Equal(t, ptrTo(1), v)
+}
@@ -61,3 +61,24 @@ func (p *EmbeddedParser) ParseCmd(_ string) (any, error) {
 func (p *EmbeddedParser) ParseEnv(_ string) (any, error) {
 	return nil, errors.ErrEnvParserIsNotImplemented
 }
+
+// BoolFlagParser is the optional interface of the parsers of flags which take no separate
+// command-line argument, like boolean flags of the standard library flag package.
+// Without the inline value, such flags are parsed from an empty input.
+type BoolFlagParser interface {
+	IsBoolFlag() bool
+}
+
+// LeadingDashParser is the optional interface of the parsers accepting the input which starts
+// with a dash, like negative numbers. A flag set takes the command-line argument starting
+// with a dash followed by a digit as the value of such a flag rather than a flag name.
+type LeadingDashParser interface {
+	AcceptsLeadingDash() bool
+}
+
+// acceptsLeadingDash reports whether the parser implements LeadingDashParser and accepts
+// the input which starts with a dash.
+func acceptsLeadingDash(p any) bool {
+	d, ok := p.(LeadingDashParser)
+	return ok && d.AcceptsLeadingDash()
+}